@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"hardcourt/backend/internal/database"
+	"hardcourt/backend/internal/draw"
+	"hardcourt/backend/internal/repository"
+)
+
+// reconstructCommand rebuilds the draws of completed tournaments from their
+// match results and writes them, replacing the stored draws. Stored entries
+// the results do not contradict, such as first-round seed lines, are kept.
+// It returns the exit code.
+//
+//	go run ./cmd/seed reconstruct [-tournament aus-open-2024] [-dry-run]
+func reconstructCommand(args []string) int {
+	flags := flag.NewFlagSet("reconstruct", flag.ExitOnError)
+	tournamentID := flags.String("tournament", "", "Only rebuild this tournament's draw")
+	dryRun := flags.Bool("dry-run", false, "Report what would be written without writing")
+	flags.Parse(args)
+
+	ctx := context.Background()
+	db, err := database.New(ctx, databaseURL())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to connect to database: %v\n", err)
+		return 1
+	}
+	defer db.Close()
+
+	matchRepo := repository.NewMatchRepository(db)
+	drawRepo := repository.NewTournamentDrawRepository(db)
+
+	ids := []string{*tournamentID}
+	if *tournamentID == "" {
+		if ids, err = matchRepo.GetCompletedTournamentIDs(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			return 1
+		}
+	}
+
+	failed := 0
+	for _, id := range ids {
+		matches, err := matchRepo.GetResults(ctx, id)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %s: %v\n", id, err)
+			failed++
+			continue
+		}
+		known, err := drawRepo.GetByTournament(ctx, id)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %s: %v\n", id, err)
+			failed++
+			continue
+		}
+
+		rec := draw.Reconstruct(id, matches, known)
+		for _, problem := range rec.Unplaced {
+			fmt.Printf("⚠️  %s: left out %s\n", id, problem)
+		}
+		if len(rec.Entries) == 0 {
+			fmt.Printf("⚠️  %s: no main draw results\n", id)
+			continue
+		}
+		if *dryRun {
+			fmt.Printf("✓ %s: %d matches give %d draw entries (%d stored)\n", id, len(matches), len(rec.Entries), len(known))
+			continue
+		}
+		if err := drawRepo.Replace(ctx, id, rec.Entries); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %s: %v\n", id, err)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ %s: wrote %d draw entries from %d matches\n", id, len(rec.Entries), len(matches))
+	}
+
+	fmt.Printf("Rebuilt %d of %d draws\n", len(ids)-failed, len(ids))
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
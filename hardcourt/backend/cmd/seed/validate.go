@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+// validate checks the datasets without touching the database, printing
+// each problem with its file and record. It returns the exit code: 0 when
+// clean, 1 on problems, 2 when a dataset cannot be read.
+//
+//	go run ./cmd/seed validate [-comprehensive=false] [-data dir,file.json]
+func validate(args []string) int {
+	flags := flag.NewFlagSet("validate", flag.ExitOnError)
+	comprehensive := flags.Bool("comprehensive", true, "Validate the comprehensive dataset rather than the standard one")
+	dataPaths := flags.String("data", "", "Also validate external dataset directories or files (comma-separated)")
+	flags.Parse(args)
+
+	dataset, err := loadDataset(*comprehensive, *dataPaths)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 2
+	}
+
+	problems := dataset.Validate()
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+	if len(problems) > 0 {
+		fmt.Printf("❌ %d problems in %d files\n", len(problems), len(dataset.Files))
+		return 1
+	}
+
+	fmt.Printf("✓ %d files valid: %d players, %d tournaments, %d matches, %d draw entries\n",
+		len(dataset.Files), len(dataset.Players), len(dataset.Tournaments), len(dataset.Matches), len(dataset.Draws))
+	return 0
+}
@@ -2,21 +2,28 @@ package main
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"hardcourt/backend/internal/database"
 	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/forecast"
 	"hardcourt/backend/internal/handlers"
+	"hardcourt/backend/internal/providers"
+	"hardcourt/backend/internal/reconcile"
 	"hardcourt/backend/internal/repository"
 	"hardcourt/backend/internal/scraper"
 	"hardcourt/backend/internal/scrapers"
 	"hardcourt/backend/internal/simulator"
+	"hardcourt/backend/internal/vcr"
 	"hardcourt/backend/internal/websocket"
 
 	"github.com/go-chi/chi/v5"
@@ -58,6 +65,10 @@ func main() {
 	matchRepo := repository.NewMatchRepository(db)
 	playerRepo := repository.NewPlayerRepository(db)
 	tournamentRepo := repository.NewTournamentRepository(db)
+	provenanceRepo := repository.NewProvenanceRepository(db)
+	pointRepo := repository.NewPointRepository(db)
+	drawRepo := repository.NewTournamentDrawRepository(db)
+	forecastRepo := repository.NewForecastRepository(db)
 
 	// 5. Redis Connection
 	rdb := redis.NewClient(&redis.Options{
@@ -65,20 +76,39 @@ func main() {
 	})
 	redisCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
+	var presenceStore *websocket.RedisPresenceStore
 	if _, err := rdb.Ping(redisCtx).Result(); err != nil {
 		log.Printf("Warning: Could not connect to Redis at %s: %v. Simulator will run locally only.", redisAddr, err)
 	} else {
 		log.Println("Connected to Redis")
+		presenceStore = websocket.NewRedisPresenceStore(rdb)
 	}
 
 	// 6. Core Components
 	matchUpdateChan := make(chan *domain.Match, 100)
+	matchEventChan := make(chan *domain.MatchEvent, 100)
 	hub := websocket.NewHub()
 
-	// Initialize scraper aggregator for real tennis data
+	// Aggregator persists provider data and forwards live matches to the hub
 	aggregator := scrapers.NewAggregator(matchRepo, playerRepo, tournamentRepo)
+	aggregator.Forward(matchUpdateChan, matchEventChan)
 
-	// 6a. Start Live Web Scraping Scheduler (runs every minute)
+	// When providers disagree, SOURCE_PRIORITY (comma-separated, most
+	// trusted first) decides whose fields win
+	sourcePriority := reconcile.DefaultPriority
+	if priority := os.Getenv("SOURCE_PRIORITY"); priority != "" {
+		sourcePriority = strings.Split(priority, ",")
+	}
+	aggregator.Reconcile(reconcile.NewReconciler(sourcePriority, reconcile.DefaultMaxAge), provenanceRepo)
+
+	// Tournament forecasts rerun when a stored draw match finishes;
+	// FORECAST_SIMULATIONS sets how many times each run plays the draw out
+	simulations, _ := strconv.Atoi(os.Getenv("FORECAST_SIMULATIONS"))
+	forecaster := forecast.NewService(drawRepo, matchRepo, forecastRepo, simulations)
+	aggregator.OnFinished(forecaster.MatchUpdated)
+
+	// 6a. Data providers. SCRAPER_INTERVAL sets the default for the HTML
+	// scrapers; PROVIDERS and PROVIDER_<NAME>_INTERVAL override per provider.
 	scraperInterval := 1 * time.Minute
 	if intervalStr := os.Getenv("SCRAPER_INTERVAL"); intervalStr != "" {
 		if duration, err := time.ParseDuration(intervalStr); err == nil {
@@ -86,11 +116,51 @@ func main() {
 		}
 	}
 
-	scraperScheduler := scraper.NewScheduler(tournamentRepo, playerRepo, matchRepo, scraperInterval)
+	// HTTP_CASSETTE_MODE=record saves provider traffic to HTTP_CASSETTE_DIR;
+	// replay serves it back without touching the network
+	cassettes, err := vcr.ConfigFromEnv(os.Getenv)
+	if err != nil {
+		log.Fatalf("Invalid cassette config: %v", err)
+	}
+	// Providers that share a host share its request budget
+	hostInterval := 500 * time.Millisecond
+	if intervalStr := os.Getenv("PROVIDER_HOST_INTERVAL"); intervalStr != "" {
+		if duration, err := time.ParseDuration(intervalStr); err == nil {
+			hostInterval = duration
+		}
+	}
+	providerOpts := []providers.Option{providers.WithTransport(providers.LimitHosts(hostInterval, 3))}
+	// A replayed day runs on a clock starting when it was recorded, so the
+	// schedule requests match the cassettes
+	recorder := vcr.New(cassettes)
+	providerClock, err := recorder.Clock()
+	if err != nil {
+		log.Fatalf("Failed to read cassettes: %v", err)
+	}
+	if cassettes.Mode != vcr.Passthrough {
+		log.Printf("📼 Provider HTTP traffic in %s mode using %s", cassettes.Mode, cassettes.Dir)
+		providerOpts = append(providerOpts, providers.WithTransport(recorder.Wrap), providers.WithClock(providerClock))
+	}
+
+	// Every request a provider sends counts against its hourly budget
+	counted := func(calls *providers.CallCounter) []providers.Option {
+		return append(providerOpts[:len(providerOpts):len(providerOpts)], providers.WithTransport(calls.Wrap))
+	}
+	sofascoreCalls, atpCalls, flashscoreCalls := providers.NewCallCounter(), providers.NewCallCounter(), providers.NewCallCounter()
+
+	registry := providers.NewRegistry()
+	registry.Register(scrapers.NewSofascoreClient(counted(sofascoreCalls)...), providers.Config{Interval: 30 * time.Second, Calls: sofascoreCalls})
+	registry.Register(scraper.NewATPTourScraper(counted(atpCalls)...), providers.Config{Interval: scraperInterval, Calls: atpCalls})
+	registry.Register(scraper.NewFlashScoreScraper(counted(flashscoreCalls)...), providers.Config{Interval: scraperInterval, Calls: flashscoreCalls})
+	registry.Configure(os.Getenv)
+
+	// Start runs a first poll of every provider before returning
+	scraperScheduler := providers.NewScheduler(registry, aggregator)
+	scraperScheduler.SetClock(providerClock)
 	if err := scraperScheduler.Start(); err != nil {
-		log.Printf("Warning: Failed to start scraper scheduler: %v", err)
+		log.Printf("Warning: Failed to start provider scheduler: %v", err)
 	} else {
-		log.Printf("✅ Scraper scheduler started (interval: %s)", scraperInterval)
+		log.Printf("✅ Provider scheduler started")
 	}
 
 	// Check if simulator mode is enabled via environment variable
@@ -106,14 +176,14 @@ func main() {
 		}
 	}
 
-	// Try to fetch real live matches first
-	liveMatches, err := aggregator.FetchLiveMatches(ctx)
-	if err != nil || len(liveMatches) == 0 {
+	// Fall back to the simulator when the providers found nothing live
+	liveMatches := aggregator.LiveMatches()
+	if len(liveMatches) == 0 {
 		if enableSimulator == "true" {
 			log.Printf("No real live matches available, ENABLE_SIMULATOR=true, starting simulator")
 
 			// Fallback: Use simulator for demo/testing purposes
-			sim := simulator.NewEngine(rdb, matchUpdateChan, matchRepo, playerRepo, tournamentRepo)
+			sim := simulator.NewEngine(rdb, matchUpdateChan, matchEventChan, matchRepo, playerRepo, tournamentRepo)
 			sim.InitializeMatches()
 			go sim.Start(context.Background())
 		} else {
@@ -122,19 +192,19 @@ func main() {
 			// No matches - app will show "no live matches" message
 		}
 	} else {
-		log.Printf("Found %d real live matches, starting periodic scraper", len(liveMatches))
-
-		// Use real data: Start periodic fetching (every 30 seconds)
-		go aggregator.StartPeriodicFetch(context.Background(), matchUpdateChan, 30*time.Second)
-
-		// Send initial matches to WebSocket
-		for _, match := range liveMatches {
-			matchUpdateChan <- match
-		}
+		log.Printf("Found %d real live matches from providers", len(liveMatches))
 	}
 
 	// 7. Start Background Processes
 	go hub.Run()
+	go forecaster.Start(context.Background())
+
+	// Viewer counts, summed across replicas when Redis is available
+	if presenceStore != nil {
+		go hub.RunPresence(context.Background(), presenceStore, websocket.PresenceInterval)
+	} else {
+		go hub.RunPresence(context.Background(), nil, websocket.PresenceInterval)
+	}
 
 	// Bridge Simulator -> Websocket
 	go func() {
@@ -142,10 +212,16 @@ func main() {
 			hub.BroadcastMatchUpdate(match)
 		}
 	}()
+	go func() {
+		for event := range matchEventChan {
+			hub.BroadcastEvent(event)
+		}
+	}()
 
 	// 8. Handlers
-	matchHandler := handlers.NewMatchHandler(matchRepo)
+	matchHandler := handlers.NewMatchHandler(matchRepo, pointRepo)
 	tournamentHandler := handlers.NewTournamentHandler()
+	forecastHandler := handlers.NewForecastHandler(forecaster, forecastRepo)
 
 	// 9. Router
 	r := chi.NewRouter()
@@ -190,6 +266,18 @@ func main() {
 			health["redis"] = "connected"
 		}
 
+		// Live update connections
+		health["websocket"] = hub.Metrics()
+
+		// Data providers; an open circuit breaker degrades but does not fail the check
+		providerHealth := scraperScheduler.Health()
+		for _, h := range providerHealth {
+			if h.Circuit != providers.CircuitClosed && health["status"] == "healthy" {
+				health["status"] = "degraded"
+			}
+		}
+		health["scrapers"] = providerHealth
+
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(health)
 	})
@@ -200,6 +288,22 @@ func main() {
 		r.Get("/matches", matchHandler.GetAllMatches)
 		r.Get("/matches/{id}", matchHandler.GetMatchByID)
 		r.Get("/matches/{id}/highlights", tournamentHandler.GetMatchHighlights)
+		r.Get("/matches/{id}/points", matchHandler.GetMatchPoints)
+		r.Get("/matches/{id}/viewers", func(w http.ResponseWriter, r *http.Request) {
+			matchID := chi.URLParam(r, "id")
+			// Subscribers to the match's tournament receive it too
+			var tournamentID string
+			if match, err := matchRepo.GetByID(r.Context(), matchID); err == nil && match != nil {
+				tournamentID = match.TournamentID
+			}
+			viewers, updatedAt := hub.MatchViewers(matchID, tournamentID)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"match_id":   matchID,
+				"viewers":    viewers,
+				"updated_at": updatedAt,
+			})
+		})
 		r.Get("/matches/past", tournamentHandler.GetPastMatches)
 
 		// Tournament routes
@@ -207,6 +311,8 @@ func main() {
 		r.Get("/tournaments/{id}", tournamentHandler.GetTournament)
 		r.Get("/tournaments/{id}/matches", tournamentHandler.GetTournamentMatches)
 		r.Get("/tournaments/{id}/draw", tournamentHandler.GetTournamentDraw)
+		r.Get("/tournaments/{id}/order-of-play", matchHandler.GetOrderOfPlay)
+		r.Get("/tournaments/{id}/forecast", forecastHandler.GetForecast)
 
 		// Scraper monitoring endpoint
 		r.Get("/scraper/status", func(w http.ResponseWriter, r *http.Request) {
@@ -214,6 +320,38 @@ func main() {
 			w.Header().Set("Content-Type", "application/json")
 			json.NewEncoder(w).Encode(status)
 		})
+
+		// Server-Sent Events for clients that cannot use websockets
+		r.Get("/stream", hub.ServeSSE)
+
+		// Admin routes, guarded by ADMIN_TOKEN when it is set
+		r.Route("/admin", func(r chi.Router) {
+			r.Use(adminAuth(os.Getenv("ADMIN_TOKEN")))
+
+			// Where each field of a reconciled match came from
+			r.Get("/matches/{id}/provenance", func(w http.ResponseWriter, r *http.Request) {
+				matchID := chi.URLParam(r, "id")
+				stored, err := provenanceRepo.GetByMatch(r.Context(), matchID)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				observations, current := aggregator.Observations(matchID)
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"match_id":     matchID,
+					"provenance":   stored,
+					"current":      current,
+					"observations": observations,
+				})
+			})
+		})
+
+		// Websocket hub health
+		r.Get("/ws/metrics", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(hub.Metrics())
+		})
 	})
 
 	// WebSocket Route
@@ -243,7 +381,7 @@ func main() {
 	<-sigChan
 	log.Println("Shutting down gracefully...")
 
-	// Stop scraper scheduler
+	// Stop provider polling
 	scraperScheduler.Stop()
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -253,5 +391,29 @@ func main() {
 		log.Printf("Server shutdown error: %v", err)
 	}
 
+	// Stop counting this replica's viewers
+	if presenceStore != nil {
+		if err := presenceStore.Remove(shutdownCtx); err != nil {
+			log.Printf("Failed to remove presence: %v", err)
+		}
+	}
+
 	log.Println("Server stopped")
 }
+
+// adminAuth requires "Authorization: Bearer <token>" when token is non-empty
+func adminAuth(token string) func(http.Handler) http.Handler {
+	if token == "" {
+		log.Println("Warning: ADMIN_TOKEN is not set, admin routes are unauthenticated")
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			given := []byte(r.Header.Get("Authorization"))
+			if token != "" && subtle.ConstantTimeCompare(given, []byte("Bearer "+token)) != 1 {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
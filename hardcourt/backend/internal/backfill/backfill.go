@@ -0,0 +1,223 @@
+// Package backfill walks past days through a provider's schedule and stores
+// the finished matches it finds. Progress is checkpointed after every day so
+// an interrupted run resumes where it stopped, and rerunning a range only
+// touches matches that changed.
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/providers"
+)
+
+// DateLayout is how days are written in flags and checkpoints
+const DateLayout = "2006-01-02"
+
+// Store persists backfilled matches
+type Store interface {
+	// GetMatch returns the stored match, or nil if there is none
+	GetMatch(ctx context.Context, id string) (*domain.Match, error)
+
+	// SaveMatches stores matches with their tournaments and players, all or
+	// none of them
+	SaveMatches(ctx context.Context, matches []*domain.Match) error
+}
+
+// Report counts what a backfill did
+type Report struct {
+	Days     int `json:"days"`
+	Inserted int `json:"inserted"`
+	Updated  int `json:"updated"`
+	Skipped  int `json:"skipped"`
+	Failed   int `json:"failed"`
+}
+
+func (r *Report) add(other Report) {
+	r.Days += other.Days
+	r.Inserted += other.Inserted
+	r.Updated += other.Updated
+	r.Skipped += other.Skipped
+	r.Failed += other.Failed
+}
+
+// String formats the counts for logs
+func (r Report) String() string {
+	return fmt.Sprintf("%d inserted, %d updated, %d skipped, %d failed", r.Inserted, r.Updated, r.Skipped, r.Failed)
+}
+
+// Backfiller fetches finished matches day by day from one provider
+type Backfiller struct {
+	provider providers.Provider
+	store    Store
+
+	// Stats fetches match detail for statistics the schedule lacks
+	Stats bool
+
+	// Checkpoint is the file progress is saved to; empty disables resuming
+	Checkpoint string
+}
+
+func NewBackfiller(provider providers.Provider, store Store) *Backfiller {
+	return &Backfiller{provider: provider, store: store, Stats: true}
+}
+
+// Run backfills every day from from to to, both inclusive. A checkpoint for
+// the same provider and range picks up after its last completed day; the
+// returned report includes the days done by earlier runs.
+func (b *Backfiller) Run(ctx context.Context, from, to time.Time) (Report, error) {
+	from, to = truncateDay(from), truncateDay(to)
+	if to.Before(from) {
+		return Report{}, fmt.Errorf("backfill range ends before it starts: %s to %s", from.Format(DateLayout), to.Format(DateLayout))
+	}
+
+	checkpoint := &Checkpoint{Provider: b.provider.Name(), From: from.Format(DateLayout), To: to.Format(DateLayout)}
+	day := from
+	if b.Checkpoint != "" {
+		saved, err := LoadCheckpoint(b.Checkpoint)
+		if err != nil {
+			return Report{}, err
+		}
+		switch {
+		case saved == nil:
+		case !saved.Covers(checkpoint):
+			log.Printf("⚠️  Ignoring checkpoint %s for %s %s to %s", b.Checkpoint, saved.Provider, saved.From, saved.To)
+		default:
+			checkpoint = saved
+			done, err := time.Parse(DateLayout, saved.Done)
+			if err != nil {
+				return Report{}, fmt.Errorf("failed to read checkpoint %s: %w", b.Checkpoint, err)
+			}
+			day = done.AddDate(0, 0, 1)
+			log.Printf("Resuming %s backfill after %s (%s so far)", checkpoint.Provider, saved.Done, saved.Report)
+		}
+	}
+
+	for ; !day.After(to); day = day.AddDate(0, 0, 1) {
+		report, err := b.backfillDay(ctx, day)
+		if err != nil {
+			return checkpoint.Report, err
+		}
+		checkpoint.Report.add(report)
+		checkpoint.Done = day.Format(DateLayout)
+		log.Printf("✓ %s: %s", checkpoint.Done, report)
+
+		if b.Checkpoint != "" {
+			if err := checkpoint.Save(b.Checkpoint); err != nil {
+				return checkpoint.Report, err
+			}
+		}
+	}
+
+	return checkpoint.Report, nil
+}
+
+// backfillDay ingests one day's finished matches and saves them together.
+// A failed fetch or save aborts the day so it is retried on the next run;
+// matches that fail to look up are counted and skipped.
+func (b *Backfiller) backfillDay(ctx context.Context, day time.Time) (Report, error) {
+	matches, err := b.provider.ScheduledMatches(ctx, day)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to fetch matches for %s: %w", day.Format(DateLayout), err)
+	}
+
+	report := Report{Days: 1}
+	var pending []*domain.Match
+	updates := 0
+	for _, match := range matches {
+		save, exists, err := b.ingest(ctx, match, &report)
+		if err != nil {
+			if ctx.Err() != nil {
+				return Report{}, ctx.Err()
+			}
+			log.Printf("⚠️  Failed to backfill match %s: %v", match.ID, err)
+			report.Failed++
+			continue
+		}
+		if save {
+			pending = append(pending, match)
+			if exists {
+				updates++
+			}
+		}
+	}
+
+	if len(pending) > 0 {
+		if err := b.store.SaveMatches(ctx, pending); err != nil {
+			return Report{}, fmt.Errorf("failed to save matches for %s: %w", day.Format(DateLayout), err)
+		}
+	}
+	report.Inserted += len(pending) - updates
+	report.Updated += updates
+	return report, nil
+}
+
+// ingest decides whether a finished match is saved: it is if it is new or
+// has changed
+func (b *Backfiller) ingest(ctx context.Context, match *domain.Match, report *Report) (save, exists bool, err error) {
+	// Only finished matches with a score and a tournament are worth keeping
+	if match.Status != domain.StatusFinished || len(match.Sets) == 0 || match.TournamentID == "" {
+		report.Skipped++
+		return false, false, nil
+	}
+
+	existing, err := b.store.GetMatch(ctx, match.ID)
+	if err != nil {
+		return false, false, err
+	}
+	if existing != nil && !changed(existing, match, b.Stats) {
+		report.Skipped++
+		return false, true, nil
+	}
+
+	if b.Stats && match.Stats == (domain.MatchStats{}) {
+		detail, err := b.provider.MatchDetail(ctx, match.ID)
+		switch {
+		case err == nil:
+			match.Stats = detail.Stats
+		case errors.Is(err, providers.ErrUnsupported):
+		default:
+			// Stored without stats; the next run tries again
+			log.Printf("⚠️  No stats for match %s: %v", match.ID, err)
+		}
+		// Stats still missing after the lookup are not a change, or every
+		// rerun would save the match again
+		if existing != nil && !changed(existing, match, false) {
+			report.Skipped++
+			return false, true, nil
+		}
+	}
+
+	return true, existing != nil, nil
+}
+
+// changed reports whether match has anything the stored copy lacks. With
+// missingStats, a stored match without stats counts as changed so they are
+// looked up again.
+func changed(stored, match *domain.Match, missingStats bool) bool {
+	if stored.Status != match.Status || !slices.Equal(stored.Sets, match.Sets) || winner(stored) != winner(match) {
+		return true
+	}
+	noStats := domain.MatchStats{}
+	if match.Stats != noStats {
+		return stored.Stats != match.Stats
+	}
+	return missingStats && stored.Stats == noStats
+}
+
+func winner(m *domain.Match) string {
+	if m.WinnerID == nil {
+		return ""
+	}
+	return *m.WinnerID
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
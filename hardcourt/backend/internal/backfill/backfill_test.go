@@ -0,0 +1,191 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/providers"
+)
+
+// fakeProvider serves fixed days and fails a day once if asked to
+type fakeProvider struct {
+	days    map[string][]*domain.Match
+	failOn  string
+	fetched []string
+	details int
+	noStats bool // MatchDetail is unsupported
+}
+
+func (p *fakeProvider) Name() string { return "fake" }
+
+func (p *fakeProvider) LiveMatches(ctx context.Context) ([]*domain.Match, error) {
+	return nil, providers.ErrUnsupported
+}
+
+func (p *fakeProvider) ScheduledMatches(ctx context.Context, day time.Time) ([]*domain.Match, error) {
+	date := day.Format(DateLayout)
+	p.fetched = append(p.fetched, date)
+	if date == p.failOn {
+		p.failOn = ""
+		return nil, errors.New("503 from feed")
+	}
+	// Fresh copies, as a real feed would return
+	var matches []*domain.Match
+	for _, m := range p.days[date] {
+		copied := *m
+		matches = append(matches, &copied)
+	}
+	return matches, nil
+}
+
+func (p *fakeProvider) Rankings(ctx context.Context) ([]*domain.Player, error) {
+	return nil, providers.ErrUnsupported
+}
+
+func (p *fakeProvider) Tournaments(ctx context.Context) ([]*domain.Tournament, error) {
+	return nil, providers.ErrUnsupported
+}
+
+func (p *fakeProvider) MatchDetail(ctx context.Context, matchID string) (*domain.Match, error) {
+	p.details++
+	if p.noStats {
+		return nil, providers.ErrUnsupported
+	}
+	return &domain.Match{ID: matchID, Stats: domain.MatchStats{AcesP1: 7, AcesP2: 3}}, nil
+}
+
+type memoryStore struct {
+	matches map[string]*domain.Match
+	failing bool
+}
+
+func (s *memoryStore) GetMatch(ctx context.Context, id string) (*domain.Match, error) {
+	return s.matches[id], nil
+}
+
+func (s *memoryStore) SaveMatches(ctx context.Context, matches []*domain.Match) error {
+	if s.failing {
+		return errors.New("deadlock detected")
+	}
+	for _, match := range matches {
+		s.matches[match.ID] = match
+	}
+	return nil
+}
+
+func finished(id string) *domain.Match {
+	winner := "p1"
+	return &domain.Match{
+		ID: id, TournamentID: "ao-2025", Status: domain.StatusFinished, WinnerID: &winner,
+		Sets: []domain.SetScore{{SetNumber: 1, GamesP1: 6, GamesP2: 4}, {SetNumber: 2, GamesP1: 6, GamesP2: 3}},
+	}
+}
+
+func TestBackfiller_ResumesAndIsIdempotent(t *testing.T) {
+	provider := &fakeProvider{
+		days: map[string][]*domain.Match{
+			"2025-01-24": {finished("m1"), {ID: "m2", TournamentID: "ao-2025", Status: domain.StatusScheduled}},
+			"2025-01-25": {finished("m3")},
+			"2025-01-26": {finished("m4")},
+		},
+		failOn: "2025-01-25",
+	}
+	store := &memoryStore{matches: map[string]*domain.Match{}}
+	b := NewBackfiller(provider, store)
+	b.Checkpoint = filepath.Join(t.TempDir(), "checkpoint.json")
+
+	from := time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 26, 0, 0, 0, 0, time.UTC)
+
+	// The second day fails, leaving the first checkpointed
+	if _, err := b.Run(context.Background(), from, to); err == nil {
+		t.Fatal("Expected the failed day to stop the run")
+	}
+	checkpoint, err := LoadCheckpoint(b.Checkpoint)
+	if err != nil || checkpoint == nil || checkpoint.Done != "2025-01-24" {
+		t.Fatalf("Expected a checkpoint after the first day, got %+v (%v)", checkpoint, err)
+	}
+
+	// A rerun picks up at the failed day
+	provider.fetched = nil
+	report, err := b.Run(context.Background(), from, to)
+	if err != nil {
+		t.Fatalf("Resumed run failed: %v", err)
+	}
+	if len(provider.fetched) != 2 || provider.fetched[0] != "2025-01-25" {
+		t.Errorf("Expected the rerun to resume at 2025-01-25, fetched %v", provider.fetched)
+	}
+	want := Report{Days: 3, Inserted: 3, Skipped: 1}
+	if report != want {
+		t.Errorf("Report = %+v, want %+v", report, want)
+	}
+	if stats := store.matches["m1"].Stats; stats.AcesP1 != 7 {
+		t.Errorf("Expected stats from match detail, got %+v", stats)
+	}
+
+	// Without the checkpoint the same range changes nothing
+	b.Checkpoint = ""
+	details := provider.details
+	report, err = b.Run(context.Background(), from, to)
+	if err != nil {
+		t.Fatalf("Repeat run failed: %v", err)
+	}
+	if want := (Report{Days: 3, Skipped: 4}); report != want {
+		t.Errorf("Repeat report = %+v, want %+v", report, want)
+	}
+	if provider.details != details {
+		t.Errorf("Expected unchanged matches not to be fetched again")
+	}
+
+	// A corrected score is an update
+	provider.days["2025-01-26"][0].Sets = append(provider.days["2025-01-26"][0].Sets, domain.SetScore{SetNumber: 3, GamesP1: 7, GamesP2: 5})
+	report, err = b.Run(context.Background(), to, to)
+	if err != nil || report.Updated != 1 {
+		t.Errorf("Expected a changed match to be updated, got %+v (%v)", report, err)
+	}
+}
+
+func TestBackfiller_IdempotentWithoutStats(t *testing.T) {
+	day := time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC)
+	provider := &fakeProvider{days: map[string][]*domain.Match{"2025-01-24": {finished("m1")}}, noStats: true}
+	store := &memoryStore{matches: map[string]*domain.Match{}}
+	b := NewBackfiller(provider, store)
+
+	if report, err := b.Run(context.Background(), day, day); err != nil || report.Inserted != 1 {
+		t.Fatalf("Expected m1 inserted, got %+v (%v)", report, err)
+	}
+
+	// Stats are looked up again, but a match still without them is unchanged
+	report, err := b.Run(context.Background(), day, day)
+	if err != nil {
+		t.Fatalf("Repeat run failed: %v", err)
+	}
+	if want := (Report{Days: 1, Skipped: 1}); report != want {
+		t.Errorf("Repeat report = %+v, want %+v", report, want)
+	}
+}
+
+func TestBackfiller_FailedSaveRetriesTheDay(t *testing.T) {
+	provider := &fakeProvider{days: map[string][]*domain.Match{"2025-01-24": {finished("m1"), finished("m2")}}}
+	store := &memoryStore{matches: map[string]*domain.Match{}, failing: true}
+	b := NewBackfiller(provider, store)
+	b.Checkpoint = filepath.Join(t.TempDir(), "checkpoint.json")
+	day := time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC)
+
+	if _, err := b.Run(context.Background(), day, day); err == nil {
+		t.Fatal("Expected the failed save to stop the run")
+	}
+	if checkpoint, _ := LoadCheckpoint(b.Checkpoint); checkpoint != nil {
+		t.Errorf("Expected no checkpoint for the failed day, got %+v", checkpoint)
+	}
+
+	store.failing = false
+	report, err := b.Run(context.Background(), day, day)
+	if err != nil || report.Inserted != 2 || len(store.matches) != 2 {
+		t.Errorf("Expected the rerun to save both matches, got %+v (%v)", report, err)
+	}
+}
@@ -0,0 +1,59 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/repository"
+)
+
+// RepositoryStore saves backfilled matches through the repositories, each
+// day's in one bulk write
+type RepositoryStore struct {
+	matchRepo *repository.MatchRepository
+	writer    *repository.BulkWriter
+}
+
+func NewRepositoryStore(matchRepo *repository.MatchRepository, writer *repository.BulkWriter) *RepositoryStore {
+	return &RepositoryStore{matchRepo: matchRepo, writer: writer}
+}
+
+// GetMatch returns the stored match, or nil if there is none
+func (s *RepositoryStore) GetMatch(ctx context.Context, id string) (*domain.Match, error) {
+	match, err := s.matchRepo.GetByID(ctx, id)
+	if errors.Is(err, repository.ErrMatchNotFound) {
+		return nil, nil
+	}
+	return match, err
+}
+
+// SaveMatches stores matches with their tournaments and players in one
+// transaction
+func (s *RepositoryStore) SaveMatches(ctx context.Context, matches []*domain.Match) error {
+	// Historical feeds embed players without rank or country; keep what is stored
+	batch := &repository.BulkBatch{Matches: matches, MergePlayers: true}
+	tournaments := make(map[string]bool)
+	for _, match := range matches {
+		if !tournaments[match.TournamentID] {
+			tournaments[match.TournamentID] = true
+			tournament := match.Tournament
+			if tournament == nil || tournament.ID != match.TournamentID {
+				tournament = &domain.Tournament{ID: match.TournamentID}
+			}
+			batch.Tournaments = append(batch.Tournaments, tournament)
+		}
+
+		for _, player := range []*domain.Player{match.Player1, match.Player2} {
+			if player != nil {
+				batch.Players = append(batch.Players, player)
+			}
+		}
+	}
+
+	if _, err := s.writer.Write(ctx, batch); err != nil {
+		return fmt.Errorf("failed to save matches: %w", err)
+	}
+	return nil
+}
@@ -115,7 +115,7 @@ func (db *DB) RunMigrations(ctx context.Context) error {
 		// Match sets for historical data
 		`CREATE TABLE IF NOT EXISTS match_sets (
 			id SERIAL PRIMARY KEY,
-			match_id VARCHAR(255) REFERENCES matches(id),
+			match_id VARCHAR(255) REFERENCES matches(id) ON DELETE CASCADE,
 			set_number INT NOT NULL,
 			games_p1 INT NOT NULL,
 			games_p2 INT NOT NULL,
@@ -127,13 +127,66 @@ func (db *DB) RunMigrations(ctx context.Context) error {
 		// Match highlights
 		`CREATE TABLE IF NOT EXISTS match_highlights (
 			id SERIAL PRIMARY KEY,
-			match_id VARCHAR(255) REFERENCES matches(id),
+			match_id VARCHAR(255) REFERENCES matches(id) ON DELETE CASCADE,
 			timestamp TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
 			event_type VARCHAR(50),
 			description TEXT,
 			leverage_index FLOAT
 		)`,
 
+		// Which source supplied each reconciled match field
+		`CREATE TABLE IF NOT EXISTS match_provenance (
+			match_id VARCHAR(255) REFERENCES matches(id) ON DELETE CASCADE,
+			field VARCHAR(50) NOT NULL,
+			source VARCHAR(50) NOT NULL,
+			observed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			PRIMARY KEY (match_id, field)
+		)`,
+
+		// Point log: every point of a match with the score before it
+		`CREATE TABLE IF NOT EXISTS match_points (
+			match_id VARCHAR(255) REFERENCES matches(id) ON DELETE CASCADE,
+			point_number INT NOT NULL,
+			set_number INT NOT NULL,
+			game_number INT NOT NULL,
+			server SMALLINT NOT NULL,
+			winner SMALLINT NOT NULL,
+			sets_p1 INT NOT NULL,
+			sets_p2 INT NOT NULL,
+			games_p1 INT NOT NULL,
+			games_p2 INT NOT NULL,
+			points_p1 VARCHAR(5) NOT NULL,
+			points_p2 VARCHAR(5) NOT NULL,
+			tiebreak BOOLEAN DEFAULT FALSE,
+			break_point BOOLEAN DEFAULT FALSE,
+			second_serve BOOLEAN DEFAULT FALSE,
+			outcome VARCHAR(20),
+			rally_length INT,
+			shots TEXT,
+			source VARCHAR(50),
+			PRIMARY KEY (match_id, point_number)
+		)`,
+
+		// Monte Carlo tournament forecasts, one row per run
+		`CREATE TABLE IF NOT EXISTS tournament_forecasts (
+			id SERIAL PRIMARY KEY,
+			tournament_id VARCHAR(255) REFERENCES tournaments(id) ON DELETE CASCADE,
+			simulations INT NOT NULL,
+			trigger_match_id VARCHAR(255),
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+		)`,
+
+		// Each player's chance to reach a round in a forecast, W being the title;
+		// place orders the players, likeliest champion first
+		`CREATE TABLE IF NOT EXISTS tournament_forecast_players (
+			forecast_id INT REFERENCES tournament_forecasts(id) ON DELETE CASCADE,
+			player_id VARCHAR(255) REFERENCES players(id),
+			round VARCHAR(10) NOT NULL,
+			probability DOUBLE PRECISION NOT NULL,
+			place INT NOT NULL,
+			PRIMARY KEY (forecast_id, player_id, round)
+		)`,
+
 		// Add missing columns to existing tables (safe with IF NOT EXISTS)
 		// Tournaments - add all potentially missing columns
 		`ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS year INT`,
@@ -143,6 +196,9 @@ func (db *DB) RunMigrations(ctx context.Context) error {
 		`ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS winner_id VARCHAR(255)`,
 		`ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS runner_up_id VARCHAR(255)`,
 		`ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS logo_url VARCHAR(500)`,
+		`ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS source VARCHAR(50)`,
+		`ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS external_id VARCHAR(100)`,
+		`ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS season_id VARCHAR(100)`,
 
 		// Players - add all potentially missing columns
 		`ALTER TABLE players ADD COLUMN IF NOT EXISTS points INT DEFAULT 0`,
@@ -157,16 +213,30 @@ func (db *DB) RunMigrations(ctx context.Context) error {
 		`ALTER TABLE matches ADD COLUMN IF NOT EXISTS duration_minutes INT`,
 		`ALTER TABLE matches ADD COLUMN IF NOT EXISTS court VARCHAR(100)`,
 		`ALTER TABLE matches ADD COLUMN IF NOT EXISTS is_simulated BOOLEAN DEFAULT FALSE`,
+		`ALTER TABLE matches ADD COLUMN IF NOT EXISTS court_order INT DEFAULT 0`,
+		`ALTER TABLE matches ADD COLUMN IF NOT EXISTS not_before VARCHAR(10)`,
+		`ALTER TABLE matches ADD COLUMN IF NOT EXISTS seed_p1 INT`,
+		`ALTER TABLE matches ADD COLUMN IF NOT EXISTS seed_p2 INT`,
+		`ALTER TABLE tournament_draws ADD COLUMN IF NOT EXISTS entry_type VARCHAR(10)`,
+
+		// Sets and highlights go with their match, so simulated matches can be
+		// deleted once they have played a set. Only databases created before
+		// the cascade are altered, so boots do not lock the tables.
+		cascadeMatchFK("match_sets"),
+		cascadeMatchFK("match_highlights"),
 
 		// Indexes for performance
 		`CREATE INDEX IF NOT EXISTS idx_tournaments_year ON tournaments(year DESC)`,
 		`CREATE INDEX IF NOT EXISTS idx_tournaments_status_year ON tournaments(status, year DESC)`,
 		`CREATE INDEX IF NOT EXISTS idx_tournaments_category ON tournaments(category)`,
+		`CREATE INDEX IF NOT EXISTS idx_tournaments_external ON tournaments(source, external_id)`,
 		`CREATE INDEX IF NOT EXISTS idx_matches_status ON matches(status)`,
 		`CREATE INDEX IF NOT EXISTS idx_matches_tournament ON matches(tournament_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_matches_tournament_start ON matches(tournament_id, start_time)`,
 		`CREATE INDEX IF NOT EXISTS idx_matches_start_time ON matches(start_time DESC)`,
 		`CREATE INDEX IF NOT EXISTS idx_matches_simulated ON matches(is_simulated) WHERE is_simulated = TRUE`,
 		`CREATE INDEX IF NOT EXISTS idx_highlights_match ON match_highlights(match_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_forecasts_tournament ON tournament_forecasts(tournament_id, created_at)`,
 	}
 
 	for i, migration := range migrations {
@@ -178,3 +248,18 @@ func (db *DB) RunMigrations(ctx context.Context) error {
 	log.Println("Migrations completed successfully")
 	return nil
 }
+
+// cascadeMatchFK recreates table's match_id foreign key with ON DELETE
+// CASCADE, if it does not cascade already
+func cascadeMatchFK(table string) string {
+	return fmt.Sprintf(`DO $$
+		BEGIN
+			IF EXISTS (
+				SELECT 1 FROM pg_constraint
+				WHERE conrelid = '%[1]s'::regclass AND conname = '%[1]s_match_id_fkey' AND confdeltype <> 'c'
+			) THEN
+				ALTER TABLE %[1]s DROP CONSTRAINT %[1]s_match_id_fkey,
+					ADD CONSTRAINT %[1]s_match_id_fkey FOREIGN KEY (match_id) REFERENCES matches(id) ON DELETE CASCADE;
+			END IF;
+		END $$`, table)
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+)
+
+// MatchEventType identifies what happened between two score states
+type MatchEventType string
+
+const (
+	EventPointWon        MatchEventType = "point_won"
+	EventGameWon         MatchEventType = "game_won"
+	EventBreakOfServe    MatchEventType = "break_of_serve"
+	EventSetWon          MatchEventType = "set_won"
+	EventTiebreakStarted MatchEventType = "tiebreak_started"
+	EventMatchPointSaved MatchEventType = "match_point_saved"
+	EventMatchFinished   MatchEventType = "match_finished"
+	EventStatusChanged   MatchEventType = "status_changed"
+)
+
+// MatchEvent is a typed change in a match emitted by the scoring layer
+type MatchEvent struct {
+	Type         MatchEventType `json:"type"`
+	MatchID      string         `json:"match_id"`
+	TournamentID string         `json:"tournament_id,omitempty"`
+	Player       int            `json:"player,omitempty"`     // 1 or 2: who won the point/game/set/match or saved the match point
+	SetNumber    int            `json:"set_number,omitempty"` // Set the event happened in
+	Set          *SetScore      `json:"set,omitempty"`        // Final score of the set for set_won
+	FromStatus   MatchStatus    `json:"from_status,omitempty"`
+	ToStatus     MatchStatus    `json:"to_status,omitempty"`
+	Score        ScoreState     `json:"score"` // Score after the event
+	Timestamp    time.Time      `json:"timestamp"`
+}
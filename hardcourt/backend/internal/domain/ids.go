@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PlayerIDFromName creates a URL-safe player ID from a display name.
+// Example: "J. Sinner" -> "j-sinner"
+func PlayerIDFromName(name string) string {
+	id := strings.ToLower(strings.TrimSpace(name))
+	id = strings.ReplaceAll(id, ".", "")
+	id = strings.ReplaceAll(id, " ", "-")
+	return id
+}
+
+// TournamentIDFromName creates a URL-safe tournament ID for one edition.
+// Example: "Queen's Club", 2024 -> "queens-club-2024"
+func TournamentIDFromName(name string, year int) string {
+	id := strings.ToLower(strings.TrimSpace(name))
+	id = strings.ReplaceAll(id, " ", "-")
+	id = strings.ReplaceAll(id, "'", "")
+	return fmt.Sprintf("%s-%d", id, year)
+}
@@ -29,6 +29,9 @@ type Tournament struct {
 	WinnerID   *string    `json:"winner_id,omitempty"`    // Tournament champion
 	RunnerUpID *string    `json:"runner_up_id,omitempty"` // Tournament finalist
 	LogoURL    string     `json:"logo_url,omitempty"`     // Tournament logo
+	Source     string     `json:"source,omitempty"`       // Feed the tournament was linked from
+	ExternalID string     `json:"external_id,omitempty"`  // Feed's ID for the tournament across editions
+	SeasonID   string     `json:"season_id,omitempty"`    // Feed's ID for this edition
 	CreatedAt  time.Time  `json:"created_at"`
 	UpdatedAt  time.Time  `json:"updated_at"`
 }
@@ -65,6 +68,10 @@ type Match struct {
 	WinnerID        *string     `json:"winner_id,omitempty"`
 	DurationMinutes int         `json:"duration_minutes,omitempty"`
 	Court           string      `json:"court,omitempty"`
+	CourtOrder      int         `json:"court_order,omitempty"` // Slot on the court that day, from 1
+	NotBefore       string      `json:"not_before,omitempty"`  // Local time of day, e.g. "19:00"
+	SeedP1          int         `json:"seed_p1,omitempty"`     // Tournament seeding, 0 if unseeded
+	SeedP2          int         `json:"seed_p2,omitempty"`
 	IsSimulated     bool        `json:"is_simulated"` // TRUE for simulator matches, FALSE for real
 	CreatedAt       time.Time   `json:"created_at"`
 	UpdatedAt       time.Time   `json:"updated_at"`
@@ -128,6 +135,7 @@ type TournamentDraw struct {
 	Player       *Player `json:"player,omitempty"`
 	Seed         int     `json:"seed,omitempty"`
 	Bye          bool    `json:"bye"`
+	EntryType    string  `json:"entry_type,omitempty"` // WC, Q, LL, PR or ALT; empty for direct acceptance
 }
 
 // MatchHighlight represents a key moment in a match
@@ -139,3 +147,51 @@ type MatchHighlight struct {
 	Description   string    `json:"description"`
 	LeverageIndex float64   `json:"leverage_index"`
 }
+
+// FieldProvenance records which source supplied a reconciled match field
+type FieldProvenance struct {
+	Field      string    `json:"field"`
+	Source     string    `json:"source"`
+	ObservedAt time.Time `json:"observed_at"`
+}
+
+// OrderOfPlayDay is one day of a tournament's schedule, court by court
+type OrderOfPlayDay struct {
+	Date   string          `json:"date"` // YYYY-MM-DD
+	Courts []CourtSchedule `json:"courts"`
+}
+
+// CourtSchedule lists the matches on one court in playing order
+type CourtSchedule struct {
+	Court   string   `json:"court"`
+	Matches []*Match `json:"matches"`
+}
+
+// TitleRound is the round after the final in forecasts: winning the title
+const TitleRound = "W"
+
+// TournamentForecast is a Monte Carlo forecast of the rest of a tournament
+type TournamentForecast struct {
+	ID             int              `json:"id"`
+	TournamentID   string           `json:"tournament_id"`
+	Simulations    int              `json:"simulations"`
+	TriggerMatchID string           `json:"trigger_match_id,omitempty"` // Finished match the forecast was rerun for
+	CreatedAt      time.Time        `json:"created_at"`
+	Players        []PlayerForecast `json:"players"` // Players still in the draw, likeliest champion first
+}
+
+// PlayerForecast is a player's chances in a tournament forecast
+type PlayerForecast struct {
+	PlayerID string             `json:"player_id"`
+	Name     string             `json:"name,omitempty"`
+	Reach    map[string]float64 `json:"reach"` // Chance to reach each round, e.g. "QF": 0.42
+	Win      float64            `json:"win"`   // Chance to win the title
+}
+
+// ForecastSnapshot is one run in a tournament's forecast history
+type ForecastSnapshot struct {
+	ID             int                `json:"id"`
+	CreatedAt      time.Time          `json:"created_at"`
+	TriggerMatchID string             `json:"trigger_match_id,omitempty"`
+	Win            map[string]float64 `json:"win"` // Title chance by player ID
+}
@@ -0,0 +1,31 @@
+package domain
+
+// PointOutcome is how a point ended, where the source says
+type PointOutcome string
+
+const (
+	PointAce           PointOutcome = "ace"
+	PointDoubleFault   PointOutcome = "double_fault"
+	PointServeWinner   PointOutcome = "serve_winner" // Unreturned serve that was not an ace
+	PointWinner        PointOutcome = "winner"
+	PointForcedError   PointOutcome = "forced_error"
+	PointUnforcedError PointOutcome = "unforced_error"
+)
+
+// Point is one point in a match's point log
+type Point struct {
+	MatchID     string       `json:"match_id"`
+	Number      int          `json:"number"`      // From 1 across the match
+	SetNumber   int          `json:"set_number"`  // From 1
+	GameNumber  int          `json:"game_number"` // From 1 within the set
+	Server      int          `json:"server"`      // 1 or 2
+	Winner      int          `json:"winner"`      // 1 or 2
+	Score       ScoreState   `json:"score"`       // Score before the point
+	Tiebreak    bool         `json:"tiebreak,omitempty"`
+	BreakPoint  bool         `json:"break_point,omitempty"`
+	SecondServe bool         `json:"second_serve,omitempty"`
+	Outcome     PointOutcome `json:"outcome,omitempty"`
+	RallyLength int          `json:"rally_length,omitempty"` // Shots including the serve
+	Shots       string       `json:"shots,omitempty"`        // Source notation, e.g. "4f2b3*"
+	Source      string       `json:"source,omitempty"`
+}
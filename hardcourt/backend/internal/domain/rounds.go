@@ -0,0 +1,15 @@
+package domain
+
+// MainRounds are the knockout rounds of a main draw, first to last
+var MainRounds = []string{"R128", "R64", "R32", "R16", "QF", "SF", "F"}
+
+// RoundIndex returns a round's place in MainRounds, or -1 for round robin,
+// qualifying and bronze medal rounds
+func RoundIndex(round string) int {
+	for i, r := range MainRounds {
+		if r == round {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,303 @@
+// Package draw makes main draws the way the ATP rulebook does: seeds by
+// ranking, seeds 1 and 2 at the ends, the other seeds drawn into their
+// quarters and eighths, byes for the top seeds in draws that are not a power
+// of two, and the unseeded players, qualifiers included, drawn into the
+// remaining lines. Lucky losers take the lines of players who withdraw.
+//
+// Draws are made with a seeded random number generator, so the same entry
+// list and seed always give the same draw.
+package draw
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// Entry types, as printed next to a player's name in a draw
+const (
+	EntryDirect     = ""
+	EntryWildCard   = "WC"
+	EntryQualifier  = "Q"
+	EntryLuckyLoser = "LL"
+	EntryProtected  = "PR"
+	EntryAlternate  = "ALT"
+)
+
+// Entry is a player on a tournament's entry list. A qualifier whose player
+// is not known yet has an empty PlayerID and holds a line for the winner of
+// a qualifying section.
+type Entry struct {
+	PlayerID  string `json:"player_id,omitempty"`
+	Rank      int    `json:"rank,omitempty"` // 0 if unranked
+	EntryType string `json:"entry,omitempty"`
+}
+
+// seedable reports whether an entry can be seeded: qualifiers and lucky
+// losers are not known when seeds are set
+func (e Entry) seedable() bool {
+	return e.PlayerID != "" && e.EntryType != EntryQualifier && e.EntryType != EntryLuckyLoser
+}
+
+// Format is how many players, lines, seeds and byes a draw size has
+type Format struct {
+	Size    int `json:"size"`    // Players in the draw
+	Bracket int `json:"bracket"` // Lines in the first round, a power of two
+	Seeds   int `json:"seeds"`
+	Byes    int `json:"byes"` // Given to seeds 1 to Byes
+}
+
+// formats are the ATP main draw sizes
+var formats = map[int]Format{
+	28:  {Size: 28, Bracket: 32, Seeds: 8, Byes: 4},
+	32:  {Size: 32, Bracket: 32, Seeds: 8},
+	48:  {Size: 48, Bracket: 64, Seeds: 16, Byes: 16},
+	56:  {Size: 56, Bracket: 64, Seeds: 16, Byes: 8},
+	64:  {Size: 64, Bracket: 64, Seeds: 16},
+	96:  {Size: 96, Bracket: 128, Seeds: 32, Byes: 32},
+	128: {Size: 128, Bracket: 128, Seeds: 32},
+}
+
+// FormatFor returns the format of an ATP draw size
+func FormatFor(size int) (Format, error) {
+	format, ok := formats[size]
+	if !ok {
+		return Format{}, fmt.Errorf("unsupported draw size %d: ATP draws have 28, 32, 48, 56, 64, 96 or 128 players", size)
+	}
+	return format, nil
+}
+
+// RoundName names the round a bracket of lines starts with, e.g. R128 or QF
+func RoundName(lines int) string {
+	switch lines {
+	case 2:
+		return "F"
+	case 4:
+		return "SF"
+	case 8:
+		return "QF"
+	}
+	return fmt.Sprintf("R%d", lines)
+}
+
+// Line is one first-round line of a draw
+type Line struct {
+	Entry Entry `json:"entry"`
+	Seed  int   `json:"seed,omitempty"`
+	Bye   bool  `json:"bye,omitempty"` // No player; the neighbouring seed goes through
+}
+
+// Draw is a generated main draw
+type Draw struct {
+	Format Format `json:"format"`
+	Lines  []Line `json:"lines"` // Lines[0] is line 1
+}
+
+// Generate makes a draw from an entry list, one entry per player in the
+// draw. Lucky losers are left out: they only come in through Withdraw.
+func Generate(entries []Entry, rngSeed uint64) (*Draw, error) {
+	var players []Entry
+	seen := make(map[string]bool)
+	for _, e := range entries {
+		if e.EntryType == EntryLuckyLoser {
+			continue
+		}
+		if e.PlayerID != "" {
+			if seen[e.PlayerID] {
+				return nil, fmt.Errorf("%s is on the entry list twice", e.PlayerID)
+			}
+			seen[e.PlayerID] = true
+		}
+		players = append(players, e)
+	}
+
+	format, err := FormatFor(len(players))
+	if err != nil {
+		return nil, err
+	}
+	rng := rand.New(rand.NewPCG(rngSeed, uint64(format.Size)))
+	d := &Draw{Format: format, Lines: make([]Line, format.Bracket)}
+
+	// Seeds are the best ranked players who can be seeded, in ranking order
+	ranked := make([]int, 0, len(players))
+	for i, e := range players {
+		if e.seedable() {
+			ranked = append(ranked, i)
+		}
+	}
+	sort.SliceStable(ranked, func(a, b int) bool {
+		return rankOrder(players[ranked[a]].Rank) < rankOrder(players[ranked[b]].Rank)
+	})
+	seeds := min(format.Seeds, len(ranked))
+	seeded := make(map[int]bool, seeds)
+
+	// The top seeds' opponents are byes. With fewer seeds than byes, the
+	// lines the missing seeds would take still get a bye next to them and are
+	// drawn like any other line.
+	taken := make(map[int]bool) // seed lines, with or without a seed
+	used := make(map[int]bool)  // lines with a player or a bye
+	for _, group := range seedGroups(max(seeds, format.Byes)) {
+		lines := seedLines(format.Bracket, group[0], taken)
+		rng.Shuffle(len(lines), func(i, j int) { lines[i], lines[j] = lines[j], lines[i] })
+		for seed := group[0]; seed <= group[1]; seed++ {
+			line := lines[seed-group[0]]
+			taken[line] = true
+			if seed <= format.Byes {
+				bye := sibling(line)
+				d.Lines[bye-1] = Line{Bye: true}
+				used[bye] = true
+			}
+			if seed > seeds {
+				continue
+			}
+			player := ranked[seed-1]
+			seeded[player] = true
+			d.Lines[line-1] = Line{Entry: players[player], Seed: seed}
+			used[line] = true
+		}
+	}
+
+	// Everyone else is drawn into the lines left
+	var unseeded []Entry
+	for i, e := range players {
+		if !seeded[i] {
+			unseeded = append(unseeded, e)
+		}
+	}
+	rng.Shuffle(len(unseeded), func(i, j int) { unseeded[i], unseeded[j] = unseeded[j], unseeded[i] })
+	for line := 1; line <= format.Bracket; line++ {
+		if !used[line] {
+			d.Lines[line-1] = Line{Entry: unseeded[0]}
+			unseeded = unseeded[1:]
+		}
+	}
+
+	return d, nil
+}
+
+// rankOrder sorts unranked players after ranked ones
+func rankOrder(rank int) int {
+	if rank <= 0 {
+		return math.MaxInt
+	}
+	return rank
+}
+
+// seedGroups splits seeds 1 to n into the groups drawn together:
+// 1, 2, 3–4, 5–8, 9–16 and 17–32. Each group is a first and last seed.
+func seedGroups(n int) [][2]int {
+	var groups [][2]int
+	for _, g := range [][2]int{{1, 1}, {2, 2}, {3, 4}, {5, 8}, {9, 16}, {17, 32}} {
+		if g[0] > n {
+			break
+		}
+		groups = append(groups, [2]int{g[0], min(g[1], n)})
+	}
+	return groups
+}
+
+// seedLines returns the lines the group of seeds starting at first is drawn
+// into. Seeds 1 and 2 take the top and bottom lines, 3 and 4 the top of the
+// second quarter and the bottom of the third. Each later group splits the
+// draw into as many sections as it has seeds (5–8 quarters, 9–16 eighths)
+// and takes the end of each section that no higher seed holds. A group cut
+// short by the seed count still draws from all of its lines.
+func seedLines(bracket, first int, used map[int]bool) []int {
+	switch first {
+	case 1:
+		return []int{1}
+	case 2:
+		return []int{bracket}
+	case 3:
+		return []int{bracket/4 + 1, 3 * bracket / 4}
+	}
+
+	size := bracket / (first - 1)
+	var lines []int
+	for top := 1; top <= bracket; top += size {
+		bottom := top + size - 1
+		if used[top] {
+			lines = append(lines, bottom)
+		} else {
+			lines = append(lines, top)
+		}
+	}
+	return lines
+}
+
+// sibling returns the line a line plays in the first round
+func sibling(line int) int {
+	if line%2 == 1 {
+		return line + 1
+	}
+	return line - 1
+}
+
+// ErrNotInDraw is returned when withdrawing a player who is not in the draw
+var ErrNotInDraw = errors.New("player is not in the draw")
+
+// Withdraw replaces a player who pulls out after the draw is made. An
+// unseeded player's line goes to the lucky loser. A seed's line, and bye if
+// they had one, goes to the best ranked unseeded player, who is seeded in
+// their place, and the lucky loser takes that player's line instead.
+func (d *Draw) Withdraw(playerID string, luckyLoser Entry) error {
+	if luckyLoser.PlayerID == "" {
+		return fmt.Errorf("lucky loser for %s has no player", playerID)
+	}
+	luckyLoser.EntryType = EntryLuckyLoser
+
+	out := -1
+	for i, line := range d.Lines {
+		if line.Entry.PlayerID == luckyLoser.PlayerID {
+			return fmt.Errorf("%s is already in the draw", luckyLoser.PlayerID)
+		}
+		if line.Entry.PlayerID == playerID {
+			out = i
+		}
+	}
+	if out < 0 {
+		return fmt.Errorf("%s: %w", playerID, ErrNotInDraw)
+	}
+
+	if seed := d.Lines[out].Seed; seed > 0 {
+		alternate := -1
+		for i, line := range d.Lines {
+			if line.Seed == 0 && !line.Bye && line.Entry.seedable() &&
+				(alternate < 0 || rankOrder(line.Entry.Rank) < rankOrder(d.Lines[alternate].Entry.Rank)) {
+				alternate = i
+			}
+		}
+		if alternate >= 0 {
+			d.Lines[out] = Line{Entry: d.Lines[alternate].Entry, Seed: seed}
+			out = alternate
+		}
+	}
+
+	d.Lines[out] = Line{Entry: luckyLoser}
+	return nil
+}
+
+// Entries turns the draw into a tournament's first-round draw entries
+func (d *Draw) Entries(tournamentID string) []*domain.TournamentDraw {
+	round := RoundName(d.Format.Bracket)
+	entries := make([]*domain.TournamentDraw, len(d.Lines))
+	for i, line := range d.Lines {
+		entry := &domain.TournamentDraw{
+			TournamentID: tournamentID,
+			Round:        round,
+			Position:     i + 1,
+			Seed:         line.Seed,
+			Bye:          line.Bye,
+			EntryType:    line.Entry.EntryType,
+		}
+		if id := line.Entry.PlayerID; id != "" {
+			entry.PlayerID = &id
+		}
+		entries[i] = entry
+	}
+	return entries
+}
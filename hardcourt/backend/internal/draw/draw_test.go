@@ -0,0 +1,234 @@
+package draw
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// entryList ranks players p1..pn by number, with qualifier placeholders
+// in place of the last q of them
+func entryList(n, q int) []Entry {
+	entries := make([]Entry, 0, n)
+	for i := 1; i <= n-q; i++ {
+		entries = append(entries, Entry{PlayerID: fmt.Sprintf("p%d", i), Rank: i})
+	}
+	for i := 0; i < q; i++ {
+		entries = append(entries, Entry{EntryType: EntryQualifier})
+	}
+	return entries
+}
+
+// seedLinesOf returns the lines holding seeds first to last, sorted
+func seedLinesOf(d *Draw, first, last int) []int {
+	var lines []int
+	for i, line := range d.Lines {
+		if line.Seed >= first && line.Seed <= last {
+			lines = append(lines, i+1)
+		}
+	}
+	sort.Ints(lines)
+	return lines
+}
+
+func TestGenerate_SeedPlacement(t *testing.T) {
+	for rngSeed := uint64(1); rngSeed <= 20; rngSeed++ {
+		d, err := Generate(entryList(128, 16), rngSeed)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Lines[0].Seed != 1 || d.Lines[0].Entry.PlayerID != "p1" || d.Lines[127].Seed != 2 {
+			t.Fatalf("Expected seeds 1 and 2 at the ends, got %+v and %+v", d.Lines[0], d.Lines[127])
+		}
+		want := map[[2]int][]int{
+			{3, 4}:   {33, 96},
+			{5, 8}:   {32, 64, 65, 97},
+			{9, 16}:  {16, 17, 48, 49, 80, 81, 112, 113},
+			{17, 32}: {8, 9, 24, 25, 40, 41, 56, 57, 72, 73, 88, 89, 104, 105, 120, 121},
+		}
+		for group, lines := range want {
+			if got := seedLinesOf(d, group[0], group[1]); !reflect.DeepEqual(got, lines) {
+				t.Errorf("Seed %d: seeds %d–%d on lines %v, want %v", rngSeed, group[0], group[1], got, lines)
+			}
+		}
+
+		placed := make(map[string]bool)
+		qualifiers := 0
+		for _, line := range d.Lines {
+			switch {
+			case line.Entry.EntryType == EntryQualifier:
+				qualifiers++
+			case placed[line.Entry.PlayerID]:
+				t.Fatalf("%s is in the draw twice", line.Entry.PlayerID)
+			}
+			placed[line.Entry.PlayerID] = true
+		}
+		if qualifiers != 16 || len(placed) != 113 {
+			t.Errorf("Expected 112 players and 16 qualifiers, got %d and %d", len(placed)-1, qualifiers)
+		}
+	}
+}
+
+func TestGenerate_Reproducible(t *testing.T) {
+	a, _ := Generate(entryList(32, 4), 42)
+	b, _ := Generate(entryList(32, 4), 42)
+	c, _ := Generate(entryList(32, 4), 43)
+	if !reflect.DeepEqual(a, b) {
+		t.Error("Expected the same RNG seed to make the same draw")
+	}
+	if reflect.DeepEqual(a, c) {
+		t.Error("Expected another RNG seed to make another draw")
+	}
+}
+
+func TestGenerate_Byes(t *testing.T) {
+	for _, size := range []int{28, 48, 56, 96} {
+		d, err := Generate(entryList(size, 4), 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		byes := 0
+		for i, line := range d.Lines {
+			if !line.Bye {
+				continue
+			}
+			byes++
+			if seed := d.Lines[sibling(i+1)-1].Seed; seed < 1 || seed > d.Format.Byes {
+				t.Errorf("%d draw: bye on line %d faces seed %d", size, i+1, seed)
+			}
+		}
+		if byes != d.Format.Bracket-size || byes != d.Format.Byes {
+			t.Errorf("%d draw: %d byes, want %d", size, byes, d.Format.Byes)
+		}
+	}
+}
+
+func TestGenerate_FewerSeedsThanByes(t *testing.T) {
+	// Two ranked players and 26 qualifying places still need four byes
+	d, err := Generate(entryList(28, 26), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byes, players := 0, 0
+	for _, line := range d.Lines {
+		switch {
+		case line.Bye:
+			byes++
+		case line.Entry.PlayerID != "" || line.Entry.EntryType == EntryQualifier:
+			players++
+		}
+	}
+	if byes != 4 || players != 28 {
+		t.Errorf("Expected 4 byes and 28 players, got %d and %d", byes, players)
+	}
+	if d.Lines[0].Seed != 1 || !d.Lines[1].Bye || d.Lines[31].Seed != 2 || !d.Lines[30].Bye {
+		t.Errorf("Expected byes next to seeds 1 and 2, got %+v", d.Lines)
+	}
+}
+
+func TestGenerate_QualifiersAreNotSeeded(t *testing.T) {
+	entries := entryList(32, 0)
+	entries[0].EntryType = EntryQualifier // Best ranked, but came through qualifying
+	entries = append(entries, Entry{PlayerID: "ll1", Rank: 40, EntryType: EntryLuckyLoser})
+
+	d, err := Generate(entries, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range d.Lines {
+		if line.Entry.PlayerID == "p1" && line.Seed != 0 {
+			t.Errorf("Expected the qualifier unseeded, got seed %d", line.Seed)
+		}
+		if line.Entry.PlayerID == "ll1" {
+			t.Error("Expected the lucky loser to stay out of the draw")
+		}
+	}
+	if d.Lines[0].Entry.PlayerID != "p2" {
+		t.Errorf("Expected p2 to be the top seed, got %s", d.Lines[0].Entry.PlayerID)
+	}
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	if _, err := Generate(entryList(30, 0), 1); err == nil {
+		t.Error("Expected a 30 player draw to be refused")
+	}
+	entries := entryList(32, 0)
+	entries[5].PlayerID = "p1"
+	if _, err := Generate(entries, 1); err == nil {
+		t.Error("Expected a duplicate entry to be refused")
+	}
+}
+
+func TestWithdraw(t *testing.T) {
+	d, err := Generate(entryList(28, 0), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	line := func(playerID string) (int, Line) {
+		for i, l := range d.Lines {
+			if l.Entry.PlayerID == playerID {
+				return i + 1, l
+			}
+		}
+		return 0, Line{}
+	}
+
+	// An unseeded player's line goes to the lucky loser
+	was, _ := line("p20")
+	if err := d.Withdraw("p20", Entry{PlayerID: "ll1", Rank: 60}); err != nil {
+		t.Fatal(err)
+	}
+	if at, l := line("ll1"); at != was || l.Entry.EntryType != EntryLuckyLoser || l.Seed != 0 {
+		t.Errorf("Expected ll1 on line %d, got line %d %+v", was, at, l)
+	}
+
+	// A seed with a bye is replaced by the next ranked player, p9, who keeps
+	// the seed and bye; the lucky loser takes p9's line
+	seedLine, _ := line("p2")
+	p9Line, _ := line("p9")
+	if err := d.Withdraw("p2", Entry{PlayerID: "ll2", Rank: 61}); err != nil {
+		t.Fatal(err)
+	}
+	if at, l := line("p9"); at != seedLine || l.Seed != 2 || !d.Lines[sibling(at)-1].Bye {
+		t.Errorf("Expected p9 seeded 2 on line %d with a bye, got line %d %+v", seedLine, at, l)
+	}
+	if at, _ := line("ll2"); at != p9Line {
+		t.Errorf("Expected ll2 on p9's line %d, got %d", p9Line, at)
+	}
+
+	if err := d.Withdraw("p2", Entry{PlayerID: "ll3"}); !errors.Is(err, ErrNotInDraw) {
+		t.Errorf("Expected ErrNotInDraw, got %v", err)
+	}
+	if err := d.Withdraw("p3", Entry{PlayerID: "ll1"}); err == nil {
+		t.Error("Expected a lucky loser already in the draw to be refused")
+	}
+}
+
+func TestEntries(t *testing.T) {
+	d, err := Generate(entryList(48, 2), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := d.Entries("halle-2025")
+	if len(entries) != 64 || entries[0].Round != "R64" || entries[0].Position != 1 || *entries[0].PlayerID != "p1" {
+		t.Fatalf("Unexpected first entry %+v of %d", entries[0], len(entries))
+	}
+	if !entries[1].Bye || entries[1].PlayerID != nil {
+		t.Errorf("Expected a bye on line 2, got %+v", entries[1])
+	}
+	qualifiers := 0
+	for _, e := range entries {
+		if e.EntryType == EntryQualifier {
+			qualifiers++
+			if e.PlayerID != nil {
+				t.Errorf("Expected a qualifier placeholder without a player, got %+v", e)
+			}
+		}
+	}
+	if qualifiers != 2 {
+		t.Errorf("Expected 2 qualifier lines, got %d", qualifiers)
+	}
+}
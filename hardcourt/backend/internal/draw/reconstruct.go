@@ -0,0 +1,351 @@
+package draw
+
+import (
+	"fmt"
+	"sort"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// linesIn returns how many positions the round at index r has
+func linesIn(r int) int {
+	return 2 << (len(domain.MainRounds) - 1 - r)
+}
+
+// Reconstruction is a draw rebuilt from match results
+type Reconstruction struct {
+	Entries  []*domain.TournamentDraw
+	Unplaced []string // Matches that contradict the rest, with the reason
+}
+
+type slot struct {
+	round, position int
+}
+
+type reconstructor struct {
+	tournamentID string
+	won          []map[string]*domain.Match // By round: player -> match they won
+	earliest     int
+	seeds        map[string]int
+	anchors      map[string]slot // Earliest stored position of a player
+	players      map[slot]string
+	at           []map[string]int // By round: player -> position
+	placed       map[string]bool  // Match IDs
+	result       *Reconstruction
+}
+
+// Reconstruct rebuilds a tournament's bracket from its finished main draw
+// matches. Starting from the final, each player who reached a round is
+// traced back to the first round with results, through the matches they
+// won; where a match is missing they keep the same block of the draw. A
+// match that nobody's path leads to, such as an early round whose winner's
+// next match is missing, goes into a free block where it fits.
+//
+// The known entries of a stored draw are kept where the results say
+// nothing, and decide which side of the draw a player is on wherever they
+// can. Otherwise the side with the better seed goes to the top of the top
+// half and the bottom of the bottom half, so seeds 1 and 2 end up at the
+// ends. Seeds come from the stored draw, then from the matches.
+func Reconstruct(tournamentID string, matches []*domain.Match, known []*domain.TournamentDraw) *Reconstruction {
+	rc := &reconstructor{
+		tournamentID: tournamentID,
+		won:          make([]map[string]*domain.Match, len(domain.MainRounds)),
+		earliest:     -1,
+		seeds:        make(map[string]int),
+		anchors:      make(map[string]slot),
+		players:      make(map[slot]string),
+		at:           make([]map[string]int, len(domain.MainRounds)),
+		placed:       make(map[string]bool),
+		result:       &Reconstruction{},
+	}
+	for r := range domain.MainRounds {
+		rc.won[r] = make(map[string]*domain.Match)
+		rc.at[r] = make(map[string]int)
+	}
+
+	for _, e := range known {
+		r := domain.RoundIndex(e.Round)
+		if r < 0 || e.PlayerID == nil {
+			continue
+		}
+		if a, ok := rc.anchors[*e.PlayerID]; !ok || r < a.round {
+			rc.anchors[*e.PlayerID] = slot{r, e.Position}
+		}
+		if e.Seed > 0 && rc.seeds[*e.PlayerID] == 0 {
+			rc.seeds[*e.PlayerID] = e.Seed
+		}
+	}
+
+	// Results by round, latest first, so orphans are placed biggest first
+	var results []*domain.Match
+	for _, m := range matches {
+		r := domain.RoundIndex(m.Round)
+		winner := winnerOf(m)
+		if r < 0 || m.Status != domain.StatusFinished || winner == "" {
+			continue
+		}
+		if other, ok := rc.won[r][winner]; ok {
+			rc.unplaced(m, "%s already won a %s match, %s", winner, m.Round, other.ID)
+			continue
+		}
+		rc.won[r][winner] = m
+		results = append(results, m)
+		if rc.earliest < 0 || r < rc.earliest {
+			rc.earliest = r
+		}
+		for player, seed := range map[string]int{m.Player1ID: m.SeedP1, m.Player2ID: m.SeedP2} {
+			if seed > 0 && rc.seeds[player] == 0 {
+				rc.seeds[player] = seed
+			}
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return domain.RoundIndex(results[i].Round) > domain.RoundIndex(results[j].Round) })
+
+	for _, m := range results {
+		if rc.placed[m.ID] {
+			continue
+		}
+		r := domain.RoundIndex(m.Round)
+		if r == len(domain.MainRounds)-1 {
+			if final, ok := rc.players[slot{r, 1}]; ok {
+				rc.unplaced(m, "the final is already played by %s", final)
+				continue
+			}
+			rc.placePair(m, r, 1)
+			continue
+		}
+		p, ok := rc.blockFor(m, r)
+		if !ok {
+			rc.unplaced(m, "no free %s block for %s", m.Round, winnerOf(m))
+			continue
+		}
+		rc.set(winnerOf(m), r+1, p)
+		rc.placePair(m, r, p)
+	}
+
+	rc.entries(known)
+	return rc.result
+}
+
+// placePair puts a match's players on the two lines of block p of round r,
+// then traces both back through the earlier rounds
+func (rc *reconstructor) placePair(m *domain.Match, r, p int) {
+	rc.placed[m.ID] = true
+	top, bottom := m.Player1ID, m.Player2ID
+	if rc.sideOf(bottom, r) == 2*p-1 || rc.sideOf(top, r) == 2*p ||
+		(rc.sideOf(top, r) == 0 && rc.sideOf(bottom, r) == 0 && rc.outside(bottom, top, r, p)) {
+		top, bottom = bottom, top
+	}
+	if rc.set(top, r, 2*p-1) {
+		rc.trace(top, r, 2*p-1)
+	}
+	if rc.set(bottom, r, 2*p) {
+		rc.trace(bottom, r, 2*p)
+	}
+}
+
+// trace follows a player from position pos of round r back to the first
+// round with results
+func (rc *reconstructor) trace(player string, r, pos int) {
+	if r <= rc.earliest {
+		return
+	}
+	if m, ok := rc.won[r-1][player]; ok && !rc.placed[m.ID] {
+		rc.placePair(m, r-1, pos)
+		return
+	}
+
+	// No result: the player still came through one of the two lines
+	line := 2*pos - 1
+	if side := rc.sideOf(player, r-1); side == 2*pos {
+		line = side
+	} else if side == 0 && 2*pos-1 > linesIn(r-1)/2 {
+		line = 2 * pos // Outside of the bottom half
+	}
+	if rc.set(player, r-1, line) {
+		rc.trace(player, r-1, line)
+	}
+}
+
+// blockFor picks the block of round r an orphan match goes in: below its
+// winner if they are already in the next round, where the stored draw puts
+// its players if it says, else a block whose neighbour in the next round
+// went through without a recorded match, else any free one
+func (rc *reconstructor) blockFor(m *domain.Match, r int) (int, bool) {
+	winner := winnerOf(m)
+	free := func(p int) bool {
+		next, taken := rc.players[slot{r + 1, p}]
+		_, a := rc.players[slot{r, 2*p - 1}]
+		_, b := rc.players[slot{r, 2 * p}]
+		return (!taken || next == winner) && !a && !b
+	}
+	if p := rc.at[r+1][winner]; p > 0 {
+		return p, free(p)
+	}
+	for _, player := range []string{m.Player1ID, m.Player2ID} {
+		if side := rc.sideOf(player, r); side > 0 && free((side+1)/2) {
+			return (side + 1) / 2, true
+		}
+	}
+
+	fallback := 0
+	for p := 1; p <= linesIn(r+1); p++ {
+		if !free(p) {
+			continue
+		}
+		neighbour, ok := rc.players[slot{r + 1, sibling(p)}]
+		if ok && r+2 < len(domain.MainRounds) && rc.at[r+2][neighbour] > 0 {
+			if _, beat := rc.won[r+1][neighbour]; !beat {
+				return p, true
+			}
+		}
+		if fallback == 0 {
+			fallback = p
+		}
+	}
+	return fallback, fallback > 0
+}
+
+// sideOf returns where the stored draw puts a player, or anyone they beat,
+// in round r: 0 if it does not say
+func (rc *reconstructor) sideOf(player string, r int) int {
+	if a, ok := rc.anchors[player]; ok && a.round <= r {
+		return (a.position-1)>>(r-a.round) + 1
+	}
+	for k := r - 1; k >= rc.earliest && k >= 0; k-- {
+		m, ok := rc.won[k][player]
+		if !ok {
+			continue
+		}
+		if pos := rc.sideOf(opponent(m, player), k); pos > 0 {
+			return (pos-1)>>(r-k) + 1
+		}
+	}
+	return 0
+}
+
+// outside reports whether a goes on the outer line of block p of round r
+// rather than b: the better seeded side does, on top in the top half and
+// at the bottom in the bottom half
+func (rc *reconstructor) outside(a, b string, r, p int) bool {
+	better := rankOrder(rc.bestSeed(a, r)) < rankOrder(rc.bestSeed(b, r))
+	if 2*p-1 > linesIn(r)/2 {
+		return !better
+	}
+	return better
+}
+
+// bestSeed returns the best seed among a player and those they beat before
+// round r
+func (rc *reconstructor) bestSeed(player string, r int) int {
+	best := rc.seeds[player]
+	for k := r - 1; k >= rc.earliest && k >= 0; k-- {
+		if m, ok := rc.won[k][player]; ok {
+			if seed := rc.bestSeed(opponent(m, player), k); seed > 0 && (best == 0 || seed < best) {
+				best = seed
+			}
+		}
+	}
+	return best
+}
+
+// set puts a player at a position unless it is taken or they are already
+// in the round, and reports whether it did
+func (rc *reconstructor) set(player string, r, pos int) bool {
+	if _, taken := rc.players[slot{r, pos}]; taken || rc.at[r][player] > 0 {
+		return false
+	}
+	rc.players[slot{r, pos}] = player
+	rc.at[r][player] = pos
+	return true
+}
+
+func (rc *reconstructor) unplaced(m *domain.Match, format string, args ...interface{}) {
+	rc.result.Unplaced = append(rc.result.Unplaced, fmt.Sprintf("%s: %s", m.ID, fmt.Sprintf(format, args...)))
+}
+
+// entries lists the reconstructed positions with the stored entries that do
+// not clash with them, by round and position. A player keeps the entry type
+// the stored draw gives them, or that of an open stored line they fill,
+// such as a qualifier's line drawn before qualifying ended.
+// A seed who went straight into the second round had a bye on the other
+// line of their first-round block, unless the stored draw says otherwise.
+func (rc *reconstructor) entries(known []*domain.TournamentDraw) {
+	stored := make(map[slot]*domain.TournamentDraw)
+	entryTypes := make(map[string]string)
+	for _, e := range known {
+		r := domain.RoundIndex(e.Round)
+		if r < 0 {
+			continue
+		}
+		stored[slot{r, e.Position}] = e
+		if e.PlayerID != nil && e.EntryType != "" {
+			entryTypes[*e.PlayerID] = e.EntryType
+		}
+	}
+	for s, player := range rc.players {
+		if e, ok := stored[s]; ok && e.PlayerID == nil && entryTypes[player] == "" {
+			entryTypes[player] = e.EntryType
+		}
+	}
+
+	for s, player := range rc.players {
+		id := player
+		entry := &domain.TournamentDraw{
+			TournamentID: rc.tournamentID,
+			Round:        domain.MainRounds[s.round],
+			Position:     s.position,
+			PlayerID:     &id,
+			Seed:         rc.seeds[player],
+			EntryType:    entryTypes[player],
+		}
+		rc.result.Entries = append(rc.result.Entries, entry)
+
+		bye := slot{s.round, sibling(s.position)}
+		_, taken := rc.players[bye]
+		if _, known := stored[bye]; s.round == rc.earliest && !taken && !known &&
+			rc.seeds[player] > 0 && s.round+1 < len(domain.MainRounds) && rc.at[s.round+1][player] > 0 {
+			rc.result.Entries = append(rc.result.Entries, &domain.TournamentDraw{
+				TournamentID: rc.tournamentID,
+				Round:        domain.MainRounds[bye.round],
+				Position:     bye.position,
+				Bye:          true,
+			})
+		}
+	}
+	for _, e := range known {
+		r := domain.RoundIndex(e.Round)
+		if r < 0 {
+			continue
+		}
+		if _, taken := rc.players[slot{r, e.Position}]; taken {
+			continue
+		}
+		if e.PlayerID != nil && rc.at[r][*e.PlayerID] > 0 {
+			continue
+		}
+		rc.result.Entries = append(rc.result.Entries, e)
+	}
+	sort.Slice(rc.result.Entries, func(i, j int) bool {
+		a, b := rc.result.Entries[i], rc.result.Entries[j]
+		if a.Round != b.Round {
+			return domain.RoundIndex(a.Round) < domain.RoundIndex(b.Round)
+		}
+		return a.Position < b.Position
+	})
+}
+
+// winnerOf returns a match's winner if it is one of its players
+func winnerOf(m *domain.Match) string {
+	if m.WinnerID == nil || (*m.WinnerID != m.Player1ID && *m.WinnerID != m.Player2ID) {
+		return ""
+	}
+	return *m.WinnerID
+}
+
+func opponent(m *domain.Match, player string) string {
+	if m.Player1ID == player {
+		return m.Player2ID
+	}
+	return m.Player1ID
+}
@@ -0,0 +1,214 @@
+package draw
+
+import (
+	"fmt"
+	"testing"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// result is a finished match won by the first player
+func result(id, round, winner, loser string) *domain.Match {
+	w := winner
+	return &domain.Match{ID: id, Round: round, Player1ID: winner, Player2ID: loser, WinnerID: &w, Status: domain.StatusFinished}
+}
+
+// positions indexes a reconstruction by round and player
+func positions(entries []*domain.TournamentDraw) map[string]map[string]int {
+	at := make(map[string]map[string]int)
+	for _, e := range entries {
+		if e.PlayerID == nil {
+			continue
+		}
+		if at[e.Round] == nil {
+			at[e.Round] = make(map[string]int)
+		}
+		at[e.Round][*e.PlayerID] = e.Position
+	}
+	return at
+}
+
+// checkBracket fails unless every player in a round came from the block
+// below their position
+func checkBracket(t *testing.T, rec *Reconstruction) {
+	t.Helper()
+	at := positions(rec.Entries)
+	for r := 1; r < len(domain.MainRounds); r++ {
+		for player, pos := range at[domain.MainRounds[r]] {
+			if below, ok := at[domain.MainRounds[r-1]][player]; ok && (below+1)/2 != pos {
+				t.Errorf("%s is on %s line %d but %s line %d", player, domain.MainRounds[r-1], below, domain.MainRounds[r], pos)
+			}
+		}
+	}
+}
+
+func TestReconstruct_FullBracket(t *testing.T) {
+	// p1 beats p8, p4, p2; p2 beats p7, p3; seeds by number
+	matches := []*domain.Match{
+		result("f", "F", "p1", "p2"),
+		result("sf1", "SF", "p1", "p4"),
+		result("sf2", "SF", "p2", "p3"),
+		result("qf1", "QF", "p1", "p8"),
+		result("qf2", "QF", "p4", "p5"),
+		result("qf3", "QF", "p3", "p6"),
+		result("qf4", "QF", "p2", "p7"),
+	}
+	for _, m := range matches {
+		fmt.Sscanf(m.Player1ID, "p%d", &m.SeedP1)
+		fmt.Sscanf(m.Player2ID, "p%d", &m.SeedP2)
+	}
+
+	rec := Reconstruct("rotterdam-2024", matches, nil)
+	if len(rec.Unplaced) > 0 {
+		t.Fatalf("Unexpected unplaced matches: %v", rec.Unplaced)
+	}
+	if len(rec.Entries) != 14 {
+		t.Fatalf("Expected 14 entries, got %d", len(rec.Entries))
+	}
+	checkBracket(t, rec)
+
+	at := positions(rec.Entries)
+	if at["QF"]["p1"] != 1 || at["QF"]["p2"] != 8 {
+		t.Errorf("Expected seeds 1 and 2 at the ends, got lines %d and %d", at["QF"]["p1"], at["QF"]["p2"])
+	}
+	if at["F"]["p1"] != 1 || at["F"]["p2"] != 2 {
+		t.Errorf("Expected p1 on top of the final, got %v", at["F"])
+	}
+	for _, e := range rec.Entries {
+		if e.TournamentID != "rotterdam-2024" || e.Seed == 0 {
+			t.Errorf("Expected a seeded rotterdam-2024 entry, got %+v", e)
+		}
+	}
+}
+
+func TestReconstruct_MissingMatches(t *testing.T) {
+	// The semi-final p2 won is missing, and the quarter-final p5 won leads
+	// nowhere: p5 lost the missing semi-final
+	rec := Reconstruct("t", []*domain.Match{
+		result("f", "F", "p1", "p2"),
+		result("sf1", "SF", "p1", "p3"),
+		result("qf1", "QF", "p1", "p8"),
+		result("qf2", "QF", "p3", "p4"),
+		result("qf3", "QF", "p5", "p6"),
+	}, nil)
+	if len(rec.Unplaced) > 0 {
+		t.Fatalf("Unexpected unplaced matches: %v", rec.Unplaced)
+	}
+	checkBracket(t, rec)
+
+	at := positions(rec.Entries)
+	if at["SF"]["p2"] != 4 || at["QF"]["p2"] != 8 {
+		t.Errorf("Expected p2 to keep the bottom of the draw, got SF %d QF %d", at["SF"]["p2"], at["QF"]["p2"])
+	}
+	if at["SF"]["p5"] != 3 || (at["QF"]["p5"]+1)/2 != 3 {
+		t.Errorf("Expected p5 to face p2 in the semi-final, got SF %d QF %d", at["SF"]["p5"], at["QF"]["p5"])
+	}
+}
+
+func TestReconstruct_KnownEntries(t *testing.T) {
+	// The stored draw puts p2 at the top of the QF and has a bye nobody
+	// played; p9 has a stored SF line that the results contradict
+	p2, p9 := "p2", "p9"
+	known := []*domain.TournamentDraw{
+		{Round: "QF", Position: 1, PlayerID: &p2, Seed: 2},
+		{Round: "QF", Position: 6, Bye: true},
+		{Round: "SF", Position: 1, PlayerID: &p9},
+	}
+	rec := Reconstruct("t", []*domain.Match{
+		result("f", "F", "p1", "p3"),
+		result("sf1", "SF", "p1", "p2"),
+		result("qf1", "QF", "p2", "p7"),
+	}, known)
+	checkBracket(t, rec)
+
+	at := positions(rec.Entries)
+	if at["QF"]["p2"] != 1 || at["SF"]["p2"] != 1 || at["SF"]["p1"] != 2 {
+		t.Errorf("Expected p2 where the stored draw puts them, got %v", at)
+	}
+	if _, ok := at["SF"]["p9"]; ok {
+		t.Error("Expected the contradicted stored entry to be dropped")
+	}
+	bye := false
+	for _, e := range rec.Entries {
+		if e.Round == "QF" && e.Position == 6 && e.Bye {
+			bye = true
+		}
+		if e.PlayerID != nil && *e.PlayerID == "p2" && e.Seed != 2 {
+			t.Errorf("Expected p2's stored seed, got %d", e.Seed)
+		}
+	}
+	if !bye {
+		t.Error("Expected the stored bye to be kept")
+	}
+}
+
+func TestReconstruct_Unplaced(t *testing.T) {
+	rec := Reconstruct("t", []*domain.Match{
+		result("f", "F", "p1", "p2"),
+		result("f2", "F", "p3", "p4"),
+		result("sf1", "SF", "p1", "p5"),
+		result("sf1-again", "SF", "p1", "p6"),
+		{ID: "live", Round: "SF", Player1ID: "p2", Player2ID: "p7", Status: domain.StatusLive},
+	}, nil)
+	if len(rec.Unplaced) != 2 {
+		t.Fatalf("Expected the second final and semi-final unplaced, got %v", rec.Unplaced)
+	}
+	if len(rec.Entries) != 5 {
+		t.Errorf("Expected 5 entries, got %d", len(rec.Entries))
+	}
+	checkBracket(t, rec)
+}
+
+func TestReconstruct_EntryTypesAndByes(t *testing.T) {
+	// A 12 player draw from the R16 in which seeds 1 to 4 had byes. q1 came
+	// through qualifying onto a line the stored draw left open, and wc1
+	// was a wild card.
+	q, wc := "Q", "WC"
+	wc1, p1 := "wc1", "p1"
+	known := []*domain.TournamentDraw{
+		{Round: "R16", Position: 1, PlayerID: &p1, Seed: 1},
+		{Round: "R16", Position: 3, EntryType: q},
+		{Round: "R16", Position: 5, PlayerID: &wc1, EntryType: wc},
+	}
+	matches := []*domain.Match{
+		result("r16-1", "R16", "q1", "u1"),
+		result("r16-2", "R16", "wc1", "u2"),
+		result("r16-3", "R16", "u3", "u4"),
+		result("r16-4", "R16", "u5", "u6"),
+		result("qf1", "QF", "p1", "q1"),
+		result("qf2", "QF", "p3", "wc1"),
+		result("qf3", "QF", "p4", "u3"),
+		result("qf4", "QF", "p2", "u5"),
+	}
+	matches[5].SeedP1, matches[6].SeedP1, matches[7].SeedP1 = 3, 4, 2
+	rec := Reconstruct("t", matches, known)
+	if len(rec.Unplaced) > 0 {
+		t.Fatalf("Unexpected unplaced matches: %v", rec.Unplaced)
+	}
+	checkBracket(t, rec)
+
+	at := positions(rec.Entries)
+	byes := 0
+	for _, e := range rec.Entries {
+		switch {
+		case e.Bye:
+			byes++
+			seeded := false
+			for _, seed := range []string{"p1", "p2", "p3", "p4"} {
+				seeded = seeded || (e.Round == "R16" && at["R16"][seed] == sibling(e.Position))
+			}
+			if !seeded || e.PlayerID != nil {
+				t.Errorf("Expected byes only next to seeds, got %+v", e)
+			}
+		case e.PlayerID == nil:
+			t.Errorf("Expected the qualifier's stored line to be filled, got %+v", e)
+		case *e.PlayerID == "q1" && e.EntryType != q:
+			t.Errorf("Expected q1 to keep the stored qualifier line's type in the %s, got %q", e.Round, e.EntryType)
+		case *e.PlayerID == "wc1" && e.EntryType != wc:
+			t.Errorf("Expected wc1 to keep the wild card in the %s, got %q", e.Round, e.EntryType)
+		}
+	}
+	if at["R16"]["q1"] != 3 || byes != 4 {
+		t.Errorf("Expected q1 on line 3 and 4 byes, got line %d and %d byes", at["R16"]["q1"], byes)
+	}
+}
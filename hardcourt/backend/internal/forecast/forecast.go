@@ -0,0 +1,323 @@
+// Package forecast simulates the rest of a tournament from its draw, the
+// results so far and the players' rankings, and counts how often each
+// player still in the draw reaches each round and wins the title.
+//
+// Each match is decided by the match model in internal/logic: a pre-match
+// chance from the two players' rank ratings, moved by the score for matches
+// in progress.
+package forecast
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"sort"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/logic"
+)
+
+// levels are the rounds of a main draw, first to last, then the title
+var levels = append(slices.Clip(domain.MainRounds), domain.TitleRound)
+
+// levelIndex returns a round's place in levels, or -1 for rounds that are
+// not part of a knockout bracket
+func levelIndex(round string) int {
+	if round == domain.TitleRound {
+		return len(levels) - 1
+	}
+	return domain.RoundIndex(round)
+}
+
+// positionsIn returns how many positions level l has
+func positionsIn(l int) int {
+	return 1 << (len(levels) - 1 - l)
+}
+
+// ErrNoDraw is returned for tournaments without a stored main draw
+var ErrNoDraw = errors.New("tournament has no draw")
+
+type player struct {
+	id     string // Empty for a qualifier not known yet
+	name   string
+	rank   int
+	rating float64
+}
+
+// bracket is a draw with what the results so far decide. slots[l][i] is
+// the index of the player at position i+1 of level l, or -1 if it is open.
+type bracket struct {
+	first   int
+	players []player
+	index   map[string]int
+	slots   [][]int
+	out     map[int]bool               // Players who lost
+	played  []map[string]*domain.Match // By level: player -> their match
+}
+
+// Simulate runs n simulations of the rest of a tournament and returns every
+// player still in the draw with their chances. Stored draw entries place
+// the players, with ranks from their Player; results and stored later-round
+// entries fix who went through; live matches start from their score.
+func Simulate(engine *logic.MathEngine, tournamentID string, entries []*domain.TournamentDraw, matches []*domain.Match, n int, rngSeed uint64) (*domain.TournamentForecast, error) {
+	b, err := newBracket(entries, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewPCG(rngSeed, uint64(n)))
+	reached := make([][]int, len(b.players))
+	for i := range reached {
+		reached[i] = make([]int, len(levels))
+	}
+	current := make([][]int, len(levels))
+	for l := b.first; l < len(levels); l++ {
+		current[l] = make([]int, positionsIn(l))
+	}
+	copy(current[b.first], b.slots[b.first])
+
+	for run := 0; run < n; run++ {
+		for l := b.first; l < len(levels)-1; l++ {
+			for p := range current[l+1] {
+				winner := b.slots[l+1][p]
+				if winner < 0 {
+					winner = b.play(engine, rng, l, current[l][2*p], current[l][2*p+1])
+				}
+				current[l+1][p] = winner
+				if winner >= 0 {
+					reached[winner][l+1]++
+				}
+			}
+		}
+	}
+
+	f := &domain.TournamentForecast{TournamentID: tournamentID, Simulations: n, Players: []domain.PlayerForecast{}}
+	for i, pl := range b.players {
+		if pl.id == "" || b.out[i] || !b.drawn(i) {
+			continue
+		}
+		pf := domain.PlayerForecast{PlayerID: pl.id, Name: pl.name, Reach: make(map[string]float64)}
+		for l := b.first + 1; l < len(levels)-1; l++ {
+			pf.Reach[levels[l]] = float64(reached[i][l]) / float64(n)
+		}
+		pf.Win = float64(reached[i][len(levels)-1]) / float64(n)
+		f.Players = append(f.Players, pf)
+	}
+	sort.SliceStable(f.Players, func(i, j int) bool {
+		a, b := f.Players[i], f.Players[j]
+		if a.Win != b.Win {
+			return a.Win > b.Win
+		}
+		for l := len(levels) - 2; l > 0; l-- {
+			if a.Reach[levels[l]] != b.Reach[levels[l]] {
+				return a.Reach[levels[l]] > b.Reach[levels[l]]
+			}
+		}
+		return a.PlayerID < b.PlayerID
+	})
+	return f, nil
+}
+
+// newBracket places the draw entries and works out what the results decide
+func newBracket(entries []*domain.TournamentDraw, matches []*domain.Match) (*bracket, error) {
+	b := &bracket{
+		first:  -1,
+		index:  make(map[string]int),
+		slots:  make([][]int, len(levels)),
+		out:    make(map[int]bool),
+		played: make([]map[string]*domain.Match, len(levels)),
+	}
+	for _, e := range entries {
+		if l := levelIndex(e.Round); l >= 0 && l < len(levels)-1 && (b.first < 0 || l < b.first) {
+			b.first = l
+		}
+	}
+	if b.first < 0 {
+		return nil, ErrNoDraw
+	}
+	for l := range levels {
+		b.played[l] = make(map[string]*domain.Match)
+		b.slots[l] = make([]int, positionsIn(l))
+		for p := range b.slots[l] {
+			b.slots[l][p] = -1
+		}
+	}
+
+	for _, e := range entries {
+		l := levelIndex(e.Round)
+		if l < 0 || e.Position < 1 || e.Position > positionsIn(l) || e.Bye {
+			continue
+		}
+		switch {
+		case e.PlayerID != nil:
+			rank := 0
+			name := ""
+			if e.Player != nil {
+				rank, name = e.Player.Rank, e.Player.Name
+			}
+			b.slots[l][e.Position-1] = b.add(*e.PlayerID, name, rank)
+		case l == b.first:
+			// A qualifier's line before qualifying ends
+			b.slots[l][e.Position-1] = b.addPlaceholder()
+		}
+	}
+
+	for _, m := range matches {
+		l := levelIndex(m.Round)
+		if l < 0 || l == len(levels)-1 || (m.Status != domain.StatusFinished && m.Status != domain.StatusLive) {
+			continue
+		}
+		b.played[l][m.Player1ID] = m
+		b.played[l][m.Player2ID] = m
+		for id, p := range map[string]*domain.Player{m.Player1ID: m.Player1, m.Player2ID: m.Player2} {
+			if _, ok := b.index[id]; ok && p != nil {
+				b.add(id, p.Name, p.Rank) // Fills in what the draw left out
+			}
+		}
+		if m.Status != domain.StatusFinished || m.WinnerID == nil {
+			continue
+		}
+		if i, ok := b.index[loserOf(m)]; ok {
+			b.out[i] = true
+		}
+	}
+
+	for l := b.first; l < len(levels)-1; l++ {
+		for p := range b.slots[l+1] {
+			x, y := b.fill(l, 2*p, 2*p+1), b.fill(l, 2*p+1, 2*p)
+			if b.slots[l+1][p] >= 0 {
+				continue
+			}
+			for _, i := range []int{x, y} {
+				if i < 0 || b.players[i].id == "" {
+					continue
+				}
+				if m, ok := b.played[l][b.players[i].id]; ok && m.Status == domain.StatusFinished && m.WinnerID != nil && *m.WinnerID == b.players[i].id {
+					b.slots[l+1][p] = i
+				}
+			}
+		}
+	}
+
+	// Whoever is beaten to a later position is out too, as is everyone below
+	// it in the draw
+	for l := b.first + 1; l < len(levels); l++ {
+		for p, winner := range b.slots[l] {
+			if winner < 0 {
+				continue
+			}
+			for k := b.first; k < l; k++ {
+				width := 1 << (l - k)
+				for _, i := range b.slots[k][p*width : (p+1)*width] {
+					if i >= 0 && i != winner {
+						b.out[i] = true
+					}
+				}
+			}
+		}
+	}
+	return b, nil
+}
+
+// fill puts the opponent a known player met at level l on the other line
+// of their block when the draw has no one there yet, such as a qualifier's
+// line, and returns who is at position pos
+func (b *bracket) fill(l, pos, other int) int {
+	current := b.slots[l][pos]
+	if current >= 0 && b.players[current].id != "" {
+		return current
+	}
+	known := b.slots[l][other]
+	if known < 0 || b.players[known].id == "" {
+		return current
+	}
+	m, ok := b.played[l][b.players[known].id]
+	if !ok {
+		return current
+	}
+	opponentID, opponent := m.Player2ID, m.Player2
+	if m.Player2ID == b.players[known].id {
+		opponentID, opponent = m.Player1ID, m.Player1
+	}
+	if i, ok := b.index[opponentID]; ok && b.at(l, i) {
+		return current
+	}
+	rank, name := 0, ""
+	if opponent != nil {
+		rank, name = opponent.Rank, opponent.Name
+	}
+	b.slots[l][pos] = b.add(opponentID, name, rank)
+	return b.slots[l][pos]
+}
+
+// play decides a match at level l between the players at x and y; an open
+// line is a walkover
+func (b *bracket) play(engine *logic.MathEngine, rng *rand.Rand, l, x, y int) int {
+	switch {
+	case x < 0:
+		return y
+	case y < 0:
+		return x
+	}
+	px, py := b.players[x], b.players[y]
+	prob := engine.PreMatchWinProbability(px.rating, py.rating)
+	if m, ok := b.played[l][px.id]; ok && px.id != "" && m.Status == domain.StatusLive && (m.Player1ID == py.id || m.Player2ID == py.id) {
+		s := m.Score
+		if m.Player1ID == px.id {
+			prob = engine.CalculateWinProbabilityFrom(prob, s.SetsP1, s.SetsP2, s.GamesP1, s.GamesP2, s.PointsP1, s.PointsP2, s.Serving)
+		} else {
+			prob = 1 - engine.CalculateWinProbabilityFrom(1-prob, s.SetsP1, s.SetsP2, s.GamesP1, s.GamesP2, s.PointsP1, s.PointsP2, s.Serving)
+		}
+	}
+	if rng.Float64() < prob {
+		return x
+	}
+	return y
+}
+
+func (b *bracket) add(id, name string, rank int) int {
+	if i, ok := b.index[id]; ok {
+		if b.players[i].name == "" {
+			b.players[i].name = name
+		}
+		if b.players[i].rank <= 0 && rank > 0 {
+			b.players[i].rank, b.players[i].rating = rank, logic.RankRating(rank)
+		}
+		return i
+	}
+	b.players = append(b.players, player{id: id, name: name, rank: rank, rating: logic.RankRating(rank)})
+	b.index[id] = len(b.players) - 1
+	return len(b.players) - 1
+}
+
+func (b *bracket) addPlaceholder() int {
+	b.players = append(b.players, player{rating: logic.RankRating(0)})
+	return len(b.players) - 1
+}
+
+// at reports whether a player is on a level
+func (b *bracket) at(l, i int) bool {
+	for _, j := range b.slots[l] {
+		if j == i {
+			return true
+		}
+	}
+	return false
+}
+
+// drawn reports whether a player has a position in the bracket
+func (b *bracket) drawn(i int) bool {
+	for l := b.first; l < len(levels); l++ {
+		if b.at(l, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func loserOf(m *domain.Match) string {
+	if *m.WinnerID == m.Player1ID {
+		return m.Player2ID
+	}
+	return m.Player1ID
+}
@@ -0,0 +1,164 @@
+package forecast
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/logic"
+)
+
+// quarterFinals is an eight player draw of p1..p8, ranked by number, with
+// p1 on line 1 and p2 on line 8
+func quarterFinals() []*domain.TournamentDraw {
+	var entries []*domain.TournamentDraw
+	for i, n := range []int{1, 8, 4, 5, 3, 6, 7, 2} {
+		id := fmt.Sprintf("p%d", n)
+		entries = append(entries, &domain.TournamentDraw{
+			Round: "QF", Position: i + 1, PlayerID: &id,
+			Player: &domain.Player{ID: id, Name: "Player " + id[1:], Rank: n},
+		})
+	}
+	return entries
+}
+
+func match(id, round, p1, p2 string, status domain.MatchStatus, winner string) *domain.Match {
+	m := &domain.Match{ID: id, Round: round, Player1ID: p1, Player2ID: p2, Status: status}
+	if winner != "" {
+		m.WinnerID = &winner
+	}
+	return m
+}
+
+func byPlayer(f *domain.TournamentForecast) map[string]domain.PlayerForecast {
+	players := make(map[string]domain.PlayerForecast)
+	for _, p := range f.Players {
+		players[p.PlayerID] = p
+	}
+	return players
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSimulate_BeforePlay(t *testing.T) {
+	f, err := Simulate(logic.NewMathEngine(), "rotterdam-2025", quarterFinals(), nil, 20000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Players) != 8 || f.Players[0].PlayerID != "p1" || f.Players[0].Name != "Player 1" {
+		t.Fatalf("Expected p1 first of 8 players, got %+v", f.Players)
+	}
+
+	var semis, finals, titles float64
+	for _, p := range f.Players {
+		semis += p.Reach["SF"]
+		finals += p.Reach["F"]
+		titles += p.Win
+		if _, ok := p.Reach["QF"]; ok {
+			t.Errorf("Expected no chance for the round the draw starts in, got %v", p.Reach)
+		}
+	}
+	if !near(semis, 4) || !near(finals, 2) || !near(titles, 1) {
+		t.Errorf("Expected 4 semi-finalists, 2 finalists and 1 champion, got %.3f, %.3f and %.3f", semis, finals, titles)
+	}
+
+	players := byPlayer(f)
+	if p1, p8 := players["p1"], players["p8"]; p1.Reach["SF"] < 0.7 || p8.Reach["SF"] > 0.3 {
+		t.Errorf("Expected p1 to be a strong favourite over p8, got %.3f and %.3f", p1.Reach["SF"], p8.Reach["SF"])
+	}
+
+	again, _ := Simulate(logic.NewMathEngine(), "rotterdam-2025", quarterFinals(), nil, 20000, 1)
+	if !reflect.DeepEqual(f, again) {
+		t.Error("Expected the same RNG seed to give the same forecast")
+	}
+}
+
+func TestSimulate_Results(t *testing.T) {
+	matches := []*domain.Match{
+		match("qf1", "QF", "p1", "p8", domain.StatusFinished, "p8"),
+		match("qf2", "QF", "p4", "p5", domain.StatusFinished, "p4"),
+		match("sf1", "SF", "p8", "p4", domain.StatusScheduled, ""),
+	}
+	f, err := Simulate(logic.NewMathEngine(), "t", quarterFinals(), matches, 5000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	players := byPlayer(f)
+	if _, ok := players["p1"]; ok {
+		t.Error("Expected p1, beaten in the quarter-final, to be out")
+	}
+	if _, ok := players["p5"]; ok {
+		t.Error("Expected p5 to be out")
+	}
+	if p8 := players["p8"]; p8.Reach["SF"] != 1 || p8.Reach["F"] == 0 || p8.Reach["F"] == 1 {
+		t.Errorf("Expected p8 in the semi-final with a chance of the final, got %v", p8.Reach)
+	}
+	if len(f.Players) != 6 {
+		t.Errorf("Expected 6 players left, got %d", len(f.Players))
+	}
+}
+
+func TestSimulate_LiveMatch(t *testing.T) {
+	before, _ := Simulate(logic.NewMathEngine(), "t", quarterFinals(), nil, 20000, 1)
+
+	// p2, the favourite, is two sets down to p7
+	live := match("qf4", "QF", "p7", "p2", domain.StatusLive, "")
+	live.Score = domain.ScoreState{SetsP1: 2, GamesP1: 4, GamesP2: 1, PointsP1: "40", PointsP2: "0", Serving: 1}
+	after, _ := Simulate(logic.NewMathEngine(), "t", quarterFinals(), []*domain.Match{live}, 20000, 1)
+
+	if b, a := byPlayer(before)["p7"].Reach["SF"], byPlayer(after)["p7"].Reach["SF"]; a < 0.8 || a <= b {
+		t.Errorf("Expected p7's chance of the semi-final to rise from %.3f to over 0.8, got %.3f", b, a)
+	}
+}
+
+func TestSimulate_Qualifier(t *testing.T) {
+	entries := quarterFinals()
+	entries[1].PlayerID, entries[1].Player, entries[1].EntryType = nil, nil, "Q"
+
+	f, _ := Simulate(logic.NewMathEngine(), "t", entries, nil, 1000, 1)
+	if len(f.Players) != 7 {
+		t.Errorf("Expected the qualifier's line left out until it is known, got %d players", len(f.Players))
+	}
+
+	// Once the qualifier plays, the match says who they are
+	qf := match("qf1", "QF", "q1", "p1", domain.StatusFinished, "q1")
+	qf.Player1 = &domain.Player{ID: "q1", Name: "Qualifier", Rank: 140}
+	f, _ = Simulate(logic.NewMathEngine(), "t", entries, []*domain.Match{qf}, 1000, 1)
+	q1, ok := byPlayer(f)["q1"]
+	if !ok || q1.Name != "Qualifier" || q1.Reach["SF"] != 1 {
+		t.Errorf("Expected q1 in the semi-final, got %+v", q1)
+	}
+	if _, ok := byPlayer(f)["p1"]; ok {
+		t.Error("Expected p1 to be out")
+	}
+}
+
+func TestSimulate_Finished(t *testing.T) {
+	matches := []*domain.Match{
+		match("f", "F", "p1", "p2", domain.StatusFinished, "p2"),
+	}
+	entries := quarterFinals()
+	p1, p2 := "p1", "p2"
+	entries = append(entries,
+		&domain.TournamentDraw{Round: "SF", Position: 1, PlayerID: &p1},
+		&domain.TournamentDraw{Round: "SF", Position: 4, PlayerID: &p2},
+		&domain.TournamentDraw{Round: "F", Position: 1, PlayerID: &p1},
+		&domain.TournamentDraw{Round: "F", Position: 2, PlayerID: &p2},
+	)
+
+	f, _ := Simulate(logic.NewMathEngine(), "t", entries, matches, 100, 1)
+	if len(f.Players) != 1 || f.Players[0].PlayerID != "p2" || f.Players[0].Win != 1 {
+		t.Errorf("Expected only the champion p2, got %+v", f.Players)
+	}
+}
+
+func TestSimulate_NoDraw(t *testing.T) {
+	if _, err := Simulate(logic.NewMathEngine(), "t", nil, nil, 100, 1); !errors.Is(err, ErrNoDraw) {
+		t.Errorf("Expected ErrNoDraw, got %v", err)
+	}
+}
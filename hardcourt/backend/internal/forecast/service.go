@@ -0,0 +1,166 @@
+package forecast
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"log"
+	"sync"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/logic"
+	"hardcourt/backend/internal/repository"
+)
+
+// DefaultSimulations is how many times a forecast plays out the tournament
+const DefaultSimulations = 10000
+
+// CatchUpInterval is how often Start looks for results stored by other
+// processes, such as imports and backfills, since the last forecast
+const CatchUpInterval = 5 * time.Minute
+
+// Service runs and stores tournament forecasts, and reruns a tournament's
+// forecast whenever one of its main draw matches finishes
+type Service struct {
+	drawRepo     *repository.TournamentDrawRepository
+	matchRepo    *repository.MatchRepository
+	forecastRepo *repository.ForecastRepository
+	engine       *logic.MathEngine
+	simulations  int
+
+	mu      sync.Mutex
+	queued  map[string]string // Tournament -> match that finished
+	pending chan string
+}
+
+func NewService(drawRepo *repository.TournamentDrawRepository, matchRepo *repository.MatchRepository, forecastRepo *repository.ForecastRepository, simulations int) *Service {
+	if simulations <= 0 {
+		simulations = DefaultSimulations
+	}
+	return &Service{
+		drawRepo:     drawRepo,
+		matchRepo:    matchRepo,
+		forecastRepo: forecastRepo,
+		engine:       logic.NewMathEngine(),
+		simulations:  simulations,
+		queued:       make(map[string]string),
+		pending:      make(chan string, 100),
+	}
+}
+
+// Run forecasts a tournament from its stored draw and current results and
+// stores the forecast. triggerMatchID is the match whose result prompted
+// it, if any. It returns ErrNoDraw for tournaments without a draw.
+func (s *Service) Run(ctx context.Context, tournamentID, triggerMatchID string) (*domain.TournamentForecast, error) {
+	entries, err := s.drawRepo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.matchRepo.GetByStatus(ctx, tournamentID, domain.StatusFinished, domain.StatusLive)
+	if err != nil {
+		return nil, err
+	}
+
+	// The same seed for every run of a tournament, so its history moves
+	// with the results rather than with sampling noise
+	seed := fnv.New64a()
+	seed.Write([]byte(tournamentID))
+
+	forecast, err := Simulate(s.engine, tournamentID, entries, matches, s.simulations, seed.Sum64())
+	if err != nil {
+		return nil, err
+	}
+	forecast.TriggerMatchID = triggerMatchID
+	if err := s.forecastRepo.Save(ctx, forecast); err != nil {
+		return nil, err
+	}
+	return forecast, nil
+}
+
+// MatchUpdated queues a rerun of the tournament's forecast when a real main
+// draw match has finished. Reruns for a tournament that is already queued
+// are merged. It never blocks.
+func (s *Service) MatchUpdated(m *domain.Match) {
+	if m.Status != domain.StatusFinished || m.IsSimulated || m.TournamentID == "" || levelIndex(m.Round) < 0 {
+		return
+	}
+	s.queue(m.TournamentID, m.ID)
+}
+
+// CatchUp queues a rerun for every tournament with a draw whose results
+// changed since its last forecast, such as after a restart
+func (s *Service) CatchUp(ctx context.Context) error {
+	stale, err := s.forecastRepo.GetStale(ctx, levels[:len(levels)-1])
+	if err != nil {
+		return err
+	}
+	for tournamentID, matchID := range stale {
+		s.queue(tournamentID, matchID)
+	}
+	return nil
+}
+
+func (s *Service) queue(tournamentID, matchID string) {
+	s.mu.Lock()
+	_, waiting := s.queued[tournamentID]
+	s.queued[tournamentID] = matchID
+	s.mu.Unlock()
+	if waiting {
+		return
+	}
+
+	select {
+	case s.pending <- tournamentID:
+	default:
+		s.mu.Lock()
+		delete(s.queued, tournamentID)
+		s.mu.Unlock()
+		log.Printf("⚠️  Forecast queue full, skipped the rerun of %s for %s", tournamentID, matchID)
+	}
+}
+
+// Start reruns queued forecasts one at a time until ctx is done, catching
+// up on results stored while it was not listening first and then every
+// CatchUpInterval
+func (s *Service) Start(ctx context.Context) {
+	catchUp := time.NewTicker(CatchUpInterval)
+	defer catchUp.Stop()
+	s.catchUp(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-catchUp.C:
+			s.catchUp(ctx)
+		case tournamentID := <-s.pending:
+			s.mu.Lock()
+			trigger := s.queued[tournamentID]
+			delete(s.queued, tournamentID)
+			s.mu.Unlock()
+
+			start := time.Now()
+			runCtx, cancel := context.WithTimeout(ctx, time.Minute)
+			forecast, err := s.Run(runCtx, tournamentID, trigger)
+			cancel()
+			switch {
+			case errors.Is(err, ErrNoDraw):
+				// Most tournaments have results but no draw to forecast from
+			case err != nil:
+				log.Printf("❌ Failed to forecast %s after %s: %v", tournamentID, trigger, err)
+			default:
+				log.Printf("✓ Forecast %s after %s: %d players left, %d simulations in %s",
+					tournamentID, trigger, len(forecast.Players), forecast.Simulations, time.Since(start).Round(time.Millisecond))
+			}
+		}
+	}
+}
+
+func (s *Service) catchUp(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	if err := s.CatchUp(ctx); err != nil {
+		log.Printf("⚠️  Failed to look for stale forecasts: %v", err)
+	}
+}
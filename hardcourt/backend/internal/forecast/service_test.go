@@ -0,0 +1,28 @@
+package forecast
+
+import (
+	"testing"
+
+	"hardcourt/backend/internal/domain"
+)
+
+func TestService_MatchUpdated(t *testing.T) {
+	s := NewService(nil, nil, nil, 0)
+	if s.simulations != DefaultSimulations {
+		t.Errorf("Expected %d simulations by default, got %d", DefaultSimulations, s.simulations)
+	}
+
+	s.MatchUpdated(&domain.Match{ID: "live", TournamentID: "ao", Round: "R32", Status: domain.StatusLive})
+	s.MatchUpdated(&domain.Match{ID: "sim", TournamentID: "ao", Round: "R32", Status: domain.StatusFinished, IsSimulated: true})
+	s.MatchUpdated(&domain.Match{ID: "rr", TournamentID: "finals", Round: "RR", Status: domain.StatusFinished})
+	if len(s.pending) != 0 {
+		t.Fatalf("Expected live, simulated and round robin matches to be ignored, got %d queued", len(s.pending))
+	}
+
+	s.MatchUpdated(&domain.Match{ID: "r32-1", TournamentID: "ao", Round: "R32", Status: domain.StatusFinished})
+	s.MatchUpdated(&domain.Match{ID: "r32-2", TournamentID: "ao", Round: "R32", Status: domain.StatusFinished})
+	s.MatchUpdated(&domain.Match{ID: "qf-1", TournamentID: "rg", Round: "QF", Status: domain.StatusFinished})
+	if len(s.pending) != 2 || <-s.pending != "ao" || s.queued["ao"] != "r32-2" {
+		t.Errorf("Expected one rerun of ao for r32-2, got %d queued and %v", len(s.pending)+1, s.queued)
+	}
+}
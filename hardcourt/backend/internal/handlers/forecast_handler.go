@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"hardcourt/backend/internal/forecast"
+	"hardcourt/backend/internal/repository"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ForecastHandler struct {
+	forecasts    *forecast.Service
+	forecastRepo *repository.ForecastRepository
+}
+
+func NewForecastHandler(forecasts *forecast.Service, forecastRepo *repository.ForecastRepository) *ForecastHandler {
+	return &ForecastHandler{forecasts: forecasts, forecastRepo: forecastRepo}
+}
+
+// GetForecast handles GET /api/tournaments/{id}/forecast: every remaining
+// player's chance to reach each round and win the title, and the history of
+// title chances run by run. ?player=ID limits the history to one player.
+// A tournament that has not been forecast yet is forecast on the spot.
+func (h *ForecastHandler) GetForecast(w http.ResponseWriter, r *http.Request) {
+	tournamentID := chi.URLParam(r, "id")
+
+	latest, err := h.forecastRepo.GetLatest(r.Context(), tournamentID)
+	if errors.Is(err, repository.ErrForecastNotFound) {
+		latest, err = h.forecasts.Run(r.Context(), tournamentID, "")
+	}
+	if errors.Is(err, forecast.ErrNoDraw) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	history, err := h.forecastRepo.GetHistory(r.Context(), tournamentID, r.URL.Query().Get("player"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"tournament_id": tournamentID,
+		"forecast":      latest,
+		"history":       history,
+	})
+}
@@ -2,19 +2,23 @@ package handlers
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	"hardcourt/backend/internal/repository"
+	"hardcourt/backend/internal/schedule"
 
 	"github.com/go-chi/chi/v5"
 )
 
 type MatchHandler struct {
 	matchRepo *repository.MatchRepository
+	pointRepo *repository.PointRepository
 }
 
-func NewMatchHandler(matchRepo *repository.MatchRepository) *MatchHandler {
-	return &MatchHandler{matchRepo: matchRepo}
+func NewMatchHandler(matchRepo *repository.MatchRepository, pointRepo *repository.PointRepository) *MatchHandler {
+	return &MatchHandler{matchRepo: matchRepo, pointRepo: pointRepo}
 }
 
 // GetAllMatches handles GET /api/matches
@@ -44,3 +48,61 @@ func (h *MatchHandler) GetMatchByID(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(match)
 }
+
+// GetMatchPoints handles GET /api/matches/{id}/points, the match's point
+// log in order; empty for matches nobody logged
+func (h *MatchHandler) GetMatchPoints(w http.ResponseWriter, r *http.Request) {
+	matchID := chi.URLParam(r, "id")
+
+	points, err := h.pointRepo.GetByMatch(r.Context(), matchID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(points)
+}
+
+// orderOfPlayDays is how far ahead the order of play looks without a date
+const orderOfPlayDays = 14
+
+// GetOrderOfPlay handles GET /api/tournaments/{id}/order-of-play, grouping a
+// tournament's matches by day and court. ?date=YYYY-MM-DD picks one day and
+// ?tz=Australia/Melbourne sets where days start (default UTC).
+func (h *MatchHandler) GetOrderOfPlay(w http.ResponseWriter, r *http.Request) {
+	tournamentID := chi.URLParam(r, "id")
+
+	loc := time.UTC
+	if tz := r.URL.Query().Get("tz"); tz != "" {
+		var err error
+		if loc, err = time.LoadLocation(tz); err != nil {
+			http.Error(w, fmt.Sprintf("unknown time zone %q", tz), http.StatusBadRequest)
+			return
+		}
+	}
+
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	to := from.AddDate(0, 0, orderOfPlayDays)
+	if date := r.URL.Query().Get("date"); date != "" {
+		day, err := time.ParseInLocation("2006-01-02", date, loc)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid date %q, want YYYY-MM-DD", date), http.StatusBadRequest)
+			return
+		}
+		from, to = day, day.AddDate(0, 0, 1)
+	}
+
+	matches, err := h.matchRepo.GetByTournament(r.Context(), tournamentID, from, to)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"tournament_id": tournamentID,
+		"days":          schedule.Group(matches, loc),
+	})
+}
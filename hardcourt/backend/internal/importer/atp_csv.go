@@ -0,0 +1,222 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/scoring"
+)
+
+// SourceATPCSV marks tournaments linked from ATP results files
+const SourceATPCSV = "atp-csv"
+
+// atpRequiredColumns must be in every ATP results file; the others (seeds,
+// minutes, serve stats, player details) are used when present
+var atpRequiredColumns = []string{"tourney_id", "tourney_name", "tourney_date", "winner_name", "loser_name", "score"}
+
+// atpLevels maps tourney_level onto tournament categories. Level "A"
+// covers both ATP 500 and ATP 250 events, so it is left blank rather than
+// overwrite a seeded category with a guess.
+var atpLevels = map[string]string{
+	"G": "Grand Slam",
+	"M": "Masters 1000",
+	"F": "ATP Finals",
+	"O": "Olympics",
+	"D": "Davis Cup",
+	"C": "Challenger",
+}
+
+// atpTournamentIDs covers tournament names whose IDs in the seed data do not
+// follow from the name
+var atpTournamentIDs = map[string]string{
+	"australian open": "aus-open",
+	"queen's club":    "queens",
+}
+
+// tournamentID maps a dataset's tournament name onto the seed data's ID
+// for that year's edition, e.g. "Indian Wells Masters" -> "indian-wells-2024"
+func tournamentID(name string, year int) string {
+	key := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(name), " Masters"))
+	if base, ok := atpTournamentIDs[key]; ok {
+		return fmt.Sprintf("%s-%d", base, year)
+	}
+	return domain.TournamentIDFromName(key, year)
+}
+
+// matchID follows the seed data's match IDs, "<tournament>-<p1>-vs-<p2>".
+// Outside the knockout rounds (round robin, bronze medal matches) the same
+// players can meet again in one event, so the round is added, e.g.
+// "tour-finals-2024-j-sinner-vs-t-fritz-rr".
+func matchID(tournamentID, round, player1ID, player2ID string) string {
+	id := fmt.Sprintf("%s-%s-vs-%s", tournamentID, player1ID, player2ID)
+	if round != "" && domain.RoundIndex(round) < 0 {
+		id += "-" + strings.ToLower(round)
+	}
+	return id
+}
+
+// editionYear returns the year of the edition a tourney_id such as
+// "2024-0339" belongs to, which for events starting in late December is
+// not the year of tourney_date
+func editionYear(tourneyID string, date time.Time) int {
+	if prefix, _, found := strings.Cut(tourneyID, "-"); found {
+		if year, err := strconv.Atoi(prefix); err == nil && year > 1800 {
+			return year
+		}
+	}
+	return date.Year()
+}
+
+// ReadATPResults adds one ATP results CSV to the dataset: one row per match
+// with the tourney, surface, round, both players, seeds, score, minutes and
+// serve stats. Rows that cannot be imported are recorded as RowErrors; only
+// an unreadable file or header is an error.
+func (d *Dataset) ReadATPResults(r io.Reader, file string) error {
+	return d.readCSV(r, file, "an ATP results", atpRequiredColumns, d.addATPRow)
+}
+
+func (d *Dataset) addATPRow(row csvRow) error {
+	date, err := time.Parse("20060102", row.get("tourney_date"))
+	if err != nil {
+		return fmt.Errorf("invalid tourney_date %q", row.get("tourney_date"))
+	}
+	if row.get("winner_name") == "" || row.get("loser_name") == "" {
+		return errors.New("missing player name")
+	}
+
+	setsToWin := 0
+	if bestOf := row.int("best_of"); bestOf > 0 {
+		setsToWin = (bestOf + 1) / 2
+	}
+	score := row.get("score")
+	parsed, err := scoring.ParseScore(score, setsToWin)
+	if err != nil {
+		return err
+	}
+	switch {
+	case parsed.Status != domain.StatusFinished:
+		return fmt.Errorf("score %q is not a finished match", score)
+	case parsed.Winner() == 2:
+		return fmt.Errorf("score %q has the loser winning", score)
+	}
+
+	tournament := d.atpTournament(row, date)
+	winner := d.atpPlayer(row, "winner")
+	loser := d.atpPlayer(row, "loser")
+	winnerID := winner.ID
+
+	id := matchID(tournament.ID, row.get("round"), winner.ID, loser.ID)
+	if d.hasMatch(id) {
+		return fmt.Errorf("match %s is already in the dataset", id)
+	}
+
+	match := &domain.Match{
+		ID:              id,
+		TournamentID:    tournament.ID,
+		Tournament:      tournament,
+		Player1ID:       winner.ID,
+		Player2ID:       loser.ID,
+		Player1:         winner,
+		Player2:         loser,
+		Status:          domain.StatusFinished,
+		Round:           row.get("round"),
+		StartTime:       date,
+		WinnerID:        &winnerID,
+		DurationMinutes: row.int("minutes"),
+		SeedP1:          row.int("winner_seed"),
+		SeedP2:          row.int("loser_seed"),
+		Score:           domain.ScoreState{SetsP1: parsed.Score.SetsP1, SetsP2: parsed.Score.SetsP2},
+		Sets:            parsed.Sets,
+		Stats:           atpStats(row),
+	}
+	d.Matches = append(d.Matches, match)
+	return nil
+}
+
+// atpTournament returns the dataset's tournament for a row, adding it on
+// first sight. tourney_id is "<year>-<event>"; the event code is kept as the
+// external ID shared by every edition.
+func (d *Dataset) atpTournament(row csvRow, date time.Time) *domain.Tournament {
+	name := row.get("tourney_name")
+	year := editionYear(row.get("tourney_id"), date)
+	id := tournamentID(name, year)
+
+	if t, ok := d.Tournaments[id]; ok {
+		return t
+	}
+
+	sourceID := row.get("tourney_id")
+	externalID := sourceID
+	if _, event, found := strings.Cut(sourceID, "-"); found {
+		externalID = event
+	}
+	start := date
+	t := &domain.Tournament{
+		ID:         id,
+		Name:       name,
+		Surface:    row.get("surface"),
+		StartDate:  &start,
+		Year:       year,
+		Category:   atpLevels[row.get("tourney_level")],
+		Source:     SourceATPCSV,
+		ExternalID: externalID,
+		SeasonID:   sourceID,
+	}
+	d.Tournaments[id] = t
+	return t
+}
+
+// atpPlayer resolves the winner or loser of a row, filling in details the
+// dataset's earlier rows lacked
+func (d *Dataset) atpPlayer(row csvRow, side string) *domain.Player {
+	id, name := d.resolver.Resolve(row.get(side+"_id"), row.get(side+"_name"))
+
+	p, ok := d.Players[id]
+	if !ok {
+		p = &domain.Player{ID: id, Name: name, CountryCode: "XX"}
+		d.Players[id] = p
+	}
+	if p.CountryCode == "XX" {
+		p.CountryCode = countryCode(row.get(side + "_ioc"))
+	}
+	if p.HeightCm == 0 {
+		p.HeightCm = row.int(side + "_ht")
+	}
+	if p.Plays == "" {
+		switch row.get(side + "_hand") {
+		case "R":
+			p.Plays = "Right"
+		case "L":
+			p.Plays = "Left"
+		}
+	}
+	return p
+}
+
+// atpStats maps the winner's (w_) and loser's (l_) serve stats onto player
+// 1 and 2. Break points count those converted, i.e. the opponent's faced
+// less saved.
+func atpStats(row csvRow) domain.MatchStats {
+	return domain.MatchStats{
+		AcesP1:          row.int("w_ace"),
+		AcesP2:          row.int("l_ace"),
+		DoubleFaultsP1:  row.int("w_df"),
+		DoubleFaultsP2:  row.int("l_df"),
+		BreakPointsP1:   row.int("l_bpFaced") - row.int("l_bpSaved"),
+		BreakPointsP2:   row.int("w_bpFaced") - row.int("w_bpSaved"),
+		FirstServePctP1: percentage(row.int("w_1stIn"), row.int("w_svpt")),
+		FirstServePctP2: percentage(row.int("l_1stIn"), row.int("l_svpt")),
+	}
+}
+
+func percentage(value, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(value) / float64(total) * 100
+}
@@ -0,0 +1,161 @@
+package importer
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/scoring"
+)
+
+func readFixture(t *testing.T) *Dataset {
+	t.Helper()
+	f, err := os.Open("testdata/atp_matches_2024.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	dataset := NewDataset(NewResolver())
+	if err := dataset.ReadATPResults(f, "atp_matches_2024.csv"); err != nil {
+		t.Fatalf("ReadATPResults failed: %v", err)
+	}
+	return dataset
+}
+
+func TestReadATPResults(t *testing.T) {
+	dataset := readFixture(t)
+
+	if dataset.Rows != 11 || len(dataset.Matches) != 8 {
+		t.Fatalf("Expected 8 of 11 rows imported, got %d of %d", len(dataset.Matches), dataset.Rows)
+	}
+
+	// An unfinished score, a loser winning and a repeated row are rejected
+	// with their line
+	rejected := []int{}
+	for _, r := range dataset.Rejected {
+		rejected = append(rejected, r.Line)
+	}
+	if len(rejected) != 3 || rejected[0] != 7 || rejected[1] != 8 || rejected[2] != 12 {
+		t.Fatalf("Expected rows 7, 8 and 12 rejected, got %v", dataset.Rejected)
+	}
+	if !strings.Contains(dataset.Rejected[2].Error(), "already in the dataset") {
+		t.Errorf("Expected a duplicate match rejection, got %q", dataset.Rejected[2].Error())
+	}
+	if !strings.HasPrefix(dataset.Rejected[0].Error(), "atp_matches_2024.csv:7: ") {
+		t.Errorf("Expected a file and line reference, got %q", dataset.Rejected[0].Error())
+	}
+
+	// Tournament IDs line up with the seed data
+	ao, ok := dataset.Tournaments["aus-open-2024"]
+	if !ok || ao.Category != "Grand Slam" || ao.ExternalID != "580" || ao.SeasonID != "2024-580" {
+		t.Errorf("Unexpected Australian Open: %+v", ao)
+	}
+	if _, ok := dataset.Tournaments["indian-wells-2024"]; !ok {
+		t.Errorf("Expected \"Indian Wells Masters\" as indian-wells-2024, got %v", dataset.Tournaments)
+	}
+
+	// Brisbane 2024 starts on 31 December 2023; the edition comes from the
+	// tourney_id
+	if brisbane, ok := dataset.Tournaments["brisbane-2024"]; !ok || brisbane.Year != 2024 {
+		t.Errorf("Expected Brisbane as brisbane-2024, got %+v", dataset.Tournaments)
+	}
+
+	// Players resolve to the seeder's IDs, once each
+	medvedev := dataset.Players["d-medvedev"]
+	if medvedev == nil || medvedev.Name != "D. Medvedev" || medvedev.CountryCode != "RU" || medvedev.HeightCm != 198 {
+		t.Errorf("Unexpected Medvedev: %+v", medvedev)
+	}
+	if len(dataset.Players) != 10 {
+		t.Errorf("Expected 10 players, got %d", len(dataset.Players))
+	}
+
+	final := dataset.Matches[0]
+	if final.ID != "aus-open-2024-j-sinner-vs-d-medvedev" || final.Round != "F" || final.SeedP1 != 4 || final.SeedP2 != 3 {
+		t.Errorf("Unexpected final: %s %s seeds %d/%d", final.ID, final.Round, final.SeedP1, final.SeedP2)
+	}
+	if *final.WinnerID != "j-sinner" || final.DurationMinutes != 223 || scoring.FormatSets(final.Sets) != "3-6 3-6 6-4 6-4 6-3" {
+		t.Errorf("Unexpected result: winner %s, %d minutes, %s", *final.WinnerID, final.DurationMinutes, scoring.FormatSets(final.Sets))
+	}
+	if final.Score.SetsP1 != 3 || final.Score.SetsP2 != 2 {
+		t.Errorf("Expected sets 3-2, got %d-%d", final.Score.SetsP1, final.Score.SetsP2)
+	}
+	want := domain.MatchStats{
+		AcesP1: 8, AcesP2: 11, DoubleFaultsP1: 1, DoubleFaultsP2: 4,
+		BreakPointsP1: 4, BreakPointsP2: 2,
+		FirstServePctP1: percentage(100, 150), FirstServePctP2: percentage(90, 146),
+	}
+	if final.Stats != want {
+		t.Errorf("Stats = %+v, want %+v", final.Stats, want)
+	}
+
+	// Matches ended early keep their winner and partial score
+	retired, walkover := dataset.Matches[3], dataset.Matches[4]
+	if *retired.WinnerID != "a-zverev" || len(retired.Sets) != 2 {
+		t.Errorf("Unexpected retirement: %+v", retired)
+	}
+	if *walkover.WinnerID != "h-rune" || len(walkover.Sets) != 0 || walkover.Stats != (domain.MatchStats{}) {
+		t.Errorf("Unexpected walkover: %+v", walkover)
+	}
+}
+
+func TestReadATPResults_RepeatMeeting(t *testing.T) {
+	dataset := readFixture(t)
+
+	// The same two players meet in the round robin and the final
+	roundRobin, final := dataset.Matches[6], dataset.Matches[7]
+	if roundRobin.Round != "RR" || final.Round != "F" {
+		t.Fatalf("Expected the round robin match then the final, got %s and %s", roundRobin.Round, final.Round)
+	}
+	if roundRobin.ID != "tour-finals-2024-j-sinner-vs-t-fritz-rr" || final.ID != "tour-finals-2024-j-sinner-vs-t-fritz" {
+		t.Errorf("Expected distinct IDs, got %s and %s", roundRobin.ID, final.ID)
+	}
+}
+
+func TestReadATPResults_NotATPFile(t *testing.T) {
+	dataset := NewDataset(NewResolver())
+	err := dataset.ReadATPResults(strings.NewReader("date,home,away\n"), "other.csv")
+	if err == nil || !strings.Contains(err.Error(), "no tourney_id column") {
+		t.Errorf("Expected a missing column error, got %v", err)
+	}
+}
+
+func TestRowError_Unwrap(t *testing.T) {
+	dataset := NewDataset(NewResolver())
+	csv := "tourney_id,tourney_name,tourney_date,winner_name,loser_name,score\n2024-1,Doha,20240219,A B,C D,6-4 6-x\n"
+	if err := dataset.ReadATPResults(strings.NewReader(csv), "bad.csv"); err != nil {
+		t.Fatal(err)
+	}
+	if len(dataset.Rejected) != 1 || !errors.Is(dataset.Rejected[0], scoring.ErrInvalidScore) {
+		t.Errorf("Expected an invalid score rejection, got %v", dataset.Rejected)
+	}
+}
+
+func TestResolver(t *testing.T) {
+	r := NewResolver()
+
+	if id, name := r.Resolve("106421", "Daniil Medvedev"); id != "d-medvedev" || name != "D. Medvedev" {
+		t.Errorf("Resolve = %s %q", id, name)
+	}
+	if id, _ := r.Resolve("", "F. Auger-Aliassime"); id != "f-auger-aliassime" {
+		t.Errorf("Expected abbreviated names to keep their ID, got %s", id)
+	}
+	if id, _ := r.Resolve("200000", "Felix Auger Aliassime"); id != "f-auger-aliassime" {
+		t.Errorf("Expected a spaced surname to match the hyphenated seed ID, got %s", id)
+	}
+
+	// A second player with the same initial and surname is told apart
+	if id, _ := r.Resolve("999", "Dmitry Medvedev"); id != "d-medvedev-999" {
+		t.Errorf("Expected a distinct ID for a namesake, got %s", id)
+	}
+	if id, _ := r.Resolve("106421", "Daniil Medvedev"); id != "d-medvedev" {
+		t.Errorf("Expected the dataset ID to keep its player, got %s", id)
+	}
+
+	r.Alias("Stan Wawrinka", "s-wawrinka")
+	if id, _ := r.Resolve("104527", "Stan Wawrinka"); id != "s-wawrinka" {
+		t.Errorf("Expected the alias, got %s", id)
+	}
+}
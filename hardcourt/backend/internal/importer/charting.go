@@ -0,0 +1,179 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// SourceCharting marks points read from shot-level charting files
+const SourceCharting = "charting"
+
+// chartingMatchColumns and chartingPointColumns must be in the matches and
+// points files of a charting dataset
+var (
+	chartingMatchColumns = []string{"match_id", "Player 1", "Player 2", "Date", "Tournament"}
+	chartingPointColumns = []string{"match_id", "Pt", "Svr", "1st", "2nd", "PtWinner"}
+)
+
+// chartingShots are the shot letters in charting notation; everything else
+// in a point is a direction, depth, modifier or ending
+const chartingShots = "fbrsvzopuylmhijktq"
+
+// chartingFaults end a serve that missed, e.g. "6n" or "4d"
+const chartingFaults = "nwdxge!V"
+
+// chartedMatch is a match from the matches file, waiting for its points
+type chartedMatch struct {
+	match     *domain.Match
+	setsToWin int
+	points    map[int]call
+}
+
+// ReadChartedPoints adds a shot-level charting dataset: a matches file with
+// the players, date, tournament and format of each match, and a points file
+// with every point's serves and shots in charting notation, e.g. "4f2b3*".
+// Points are keyed by match_id and ordered by Pt. Matches with no points
+// are left out.
+func (d *Dataset) ReadChartedPoints(matches io.Reader, matchesFile string, points io.Reader, pointsFile string) error {
+	charted := make(map[string]*chartedMatch)
+	var order []string
+
+	err := d.readCSV(matches, matchesFile, "a charting matches", chartingMatchColumns, func(row csvRow) error {
+		m, err := d.chartedMatch(row)
+		if err != nil {
+			return err
+		}
+		id := row.get("match_id")
+		if _, ok := charted[id]; !ok {
+			order = append(order, id)
+		}
+		charted[id] = m
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = d.readCSV(points, pointsFile, "a charting points", chartingPointColumns, func(row csvRow) error {
+		m, ok := charted[row.get("match_id")]
+		if !ok {
+			return fmt.Errorf("unknown match %q", row.get("match_id"))
+		}
+		number, err := strconv.Atoi(row.get("Pt"))
+		if err != nil {
+			return fmt.Errorf("invalid point number %q", row.get("Pt"))
+		}
+		c, err := chartedPoint(row)
+		if err != nil {
+			return fmt.Errorf("point %d: %w", number, err)
+		}
+		m.points[number] = c
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range order {
+		m := charted[id]
+		if len(m.points) == 0 {
+			continue
+		}
+		numbers := make([]int, 0, len(m.points))
+		for number := range m.points {
+			numbers = append(numbers, number)
+		}
+		sort.Ints(numbers)
+		calls := make([]call, len(numbers))
+		for i, number := range numbers {
+			calls[i] = m.points[number]
+		}
+
+		if err := d.addPointLog(m.match, calls, m.setsToWin, 0, SourceCharting); err != nil {
+			d.Rejected = append(d.Rejected, RowError{File: matchesFile, Err: fmt.Errorf("match %s: %w", id, err)})
+		}
+	}
+	return nil
+}
+
+func (d *Dataset) chartedMatch(row csvRow) (*chartedMatch, error) {
+	date, err := time.Parse("20060102", row.get("Date"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Date %q", row.get("Date"))
+	}
+	if row.get("Player 1") == "" || row.get("Player 2") == "" {
+		return nil, errors.New("missing player name")
+	}
+
+	tournament := d.tournament(row.get("Tournament"), date, row.get("Surface"))
+	p1 := d.pointLogPlayer(row.get("Player 1"), row.get("Pl 1 hand"))
+	p2 := d.pointLogPlayer(row.get("Player 2"), row.get("Pl 2 hand"))
+
+	setsToWin := 0
+	if bestOf := row.int("Best of"); bestOf > 0 {
+		setsToWin = (bestOf + 1) / 2
+	}
+	return &chartedMatch{
+		match:     pointLogMatch(tournament, date, row.get("Round"), p1, p2),
+		setsToWin: setsToWin,
+		points:    make(map[int]call),
+	}, nil
+}
+
+// chartedPoint reads a point's server, winner and shots
+func chartedPoint(row csvRow) (call, error) {
+	server, winner := row.int("Svr"), row.int("PtWinner")
+	if (server != 1 && server != 2) || (winner != 1 && winner != 2) {
+		return call{}, fmt.Errorf("server and winner must be 1 or 2, got %q and %q", row.get("Svr"), row.get("PtWinner"))
+	}
+	c := call{server: server, winner: winner}
+
+	first, second := row.get("1st"), row.get("2nd")
+	c.point.Shots = first
+	played := first
+	if isFault(first) {
+		c.point.SecondServe = true
+		c.point.Shots = strings.TrimSpace(first + " " + second)
+		played = second
+		if second == "" || isFault(second) {
+			c.point.Outcome = domain.PointDoubleFault
+			return c, nil
+		}
+	}
+
+	c.point.RallyLength = 1
+	for _, r := range played {
+		if strings.ContainsRune(chartingShots, r) {
+			c.point.RallyLength++
+		}
+	}
+	switch {
+	case strings.HasSuffix(played, "*") && c.point.RallyLength == 1:
+		c.point.Outcome = domain.PointAce
+	case strings.HasSuffix(played, "#") && c.point.RallyLength == 1:
+		c.point.Outcome = domain.PointServeWinner
+	case strings.HasSuffix(played, "*"):
+		c.point.Outcome = domain.PointWinner
+	case strings.HasSuffix(played, "#"):
+		c.point.Outcome = domain.PointForcedError
+	case strings.HasSuffix(played, "@"):
+		c.point.Outcome = domain.PointUnforcedError
+	}
+	return c, nil
+}
+
+// isFault reports whether a serve missed: it ends in a fault code and no
+// shot was played off it
+func isFault(serve string) bool {
+	if serve == "" || strings.ContainsAny(serve, chartingShots) {
+		return false
+	}
+	return strings.ContainsRune(chartingFaults, rune(serve[len(serve)-1]))
+}
@@ -0,0 +1,25 @@
+package importer
+
+// iocCountries maps the IOC codes results datasets use onto the ISO codes
+// stored for players
+var iocCountries = map[string]string{
+	"ARG": "AR", "AUS": "AU", "AUT": "AT", "BEL": "BE", "BIH": "BA", "BLR": "BY",
+	"BOL": "BO", "BRA": "BR", "BUL": "BG", "CAN": "CA", "CHI": "CL", "CHN": "CN",
+	"COL": "CO", "CRO": "HR", "CYP": "CY", "CZE": "CZ", "DEN": "DK", "ECU": "EC",
+	"ESP": "ES", "EST": "EE", "FIN": "FI", "FRA": "FR", "GBR": "GB", "GEO": "GE",
+	"GER": "DE", "GRE": "GR", "HKG": "HK", "HUN": "HU", "IND": "IN", "IRL": "IE",
+	"ISR": "IL", "ITA": "IT", "JPN": "JP", "KAZ": "KZ", "KOR": "KR", "LAT": "LV",
+	"LTU": "LT", "MDA": "MD", "MEX": "MX", "MON": "MC", "NED": "NL", "NOR": "NO",
+	"NZL": "NZ", "PAR": "PY", "PER": "PE", "POL": "PL", "POR": "PT", "ROU": "RO",
+	"RSA": "ZA", "RUS": "RU", "SLO": "SI", "SRB": "RS", "SUI": "CH", "SVK": "SK",
+	"SWE": "SE", "TPE": "TW", "TUN": "TN", "TUR": "TR", "UKR": "UA", "URU": "UY",
+	"USA": "US", "UZB": "UZ",
+}
+
+// countryCode converts an IOC code, keeping "XX" for unknown countries
+func countryCode(ioc string) string {
+	if code, ok := iocCountries[ioc]; ok {
+		return code
+	}
+	return "XX"
+}
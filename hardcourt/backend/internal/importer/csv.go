@@ -0,0 +1,68 @@
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// readCSV reads a dataset file with a header row, passing each record to
+// add. Records add rejects are recorded as RowErrors; only an unreadable
+// file or a header without the required columns is an error.
+func (d *Dataset) readCSV(r io.Reader, file, kind string, required []string, add func(csvRow) error) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read header of %s: %w", file, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			return fmt.Errorf("%s is not %s file: no %s column", file, kind, name)
+		}
+	}
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		d.Rows++
+		if err == nil {
+			err = add(csvRow{columns: columns, record: record})
+		}
+		if err != nil {
+			d.Rejected = append(d.Rejected, RowError{File: file, Line: line, Err: err})
+		}
+	}
+}
+
+// csvRow reads fields of one record by column name
+type csvRow struct {
+	columns map[string]int
+	record  []string
+}
+
+func (r csvRow) get(name string) string {
+	i, ok := r.columns[name]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[i])
+}
+
+// int reads a whole-number field, 0 if blank; heights and ages may be "188.0"
+func (r csvRow) int(name string) int {
+	value, err := strconv.ParseFloat(r.get(name), 64)
+	if err != nil {
+		return 0
+	}
+	return int(value)
+}
@@ -0,0 +1,72 @@
+package importer
+
+import (
+	"log"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// Resolver maps dataset players onto player IDs, so one person gets the same
+// ID whichever file, feed or seed they came from. IDs follow the seeder's
+// scheme: "Jannik Sinner" is stored as "J. Sinner" with ID "j-sinner".
+type Resolver struct {
+	bySource map[string]string // Dataset player ID -> player ID
+	owners   map[string]string // Player ID -> dataset player ID that claimed it
+	aliases  map[string]string // Lowercased full name -> player ID
+}
+
+func NewResolver() *Resolver {
+	return &Resolver{
+		bySource: make(map[string]string),
+		owners:   make(map[string]string),
+		aliases:  make(map[string]string),
+	}
+}
+
+// Alias maps a full name onto a player ID, for names the initial and
+// surname scheme gets wrong
+func (r *Resolver) Alias(name, id string) {
+	r.aliases[strings.ToLower(strings.TrimSpace(name))] = id
+}
+
+// Resolve returns the player ID and display name for a dataset player.
+// sourceID is the dataset's own player ID, or empty if it has none. When two
+// players in a dataset share an initial and surname, the later one gets
+// its dataset ID appended.
+func (r *Resolver) Resolve(sourceID, name string) (string, string) {
+	display := ShortName(name)
+	if id, ok := r.bySource[sourceID]; ok && sourceID != "" {
+		return id, display
+	}
+
+	id, ok := r.aliases[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		id = domain.PlayerIDFromName(display)
+	}
+	if sourceID == "" {
+		return id, display
+	}
+
+	if owner, claimed := r.owners[id]; claimed && owner != sourceID {
+		log.Printf("⚠️  %s (%s) shares an ID with player %s, storing as %s-%s", name, sourceID, owner, id, sourceID)
+		id = id + "-" + sourceID
+	}
+	r.owners[id] = sourceID
+	r.bySource[sourceID] = id
+	return id, display
+}
+
+// ShortName abbreviates given names to an initial: "Jannik Sinner" gives
+// "J. Sinner" and "Felix Auger Aliassime" gives "F. Auger Aliassime". Names
+// already abbreviated are returned as they are.
+func ShortName(name string) string {
+	fields := strings.Fields(name)
+	if len(fields) < 2 || strings.HasSuffix(fields[0], ".") {
+		return strings.Join(fields, " ")
+	}
+	initial, _ := utf8.DecodeRuneInString(fields[0])
+	return string(unicode.ToUpper(initial)) + ". " + strings.Join(fields[1:], " ")
+}
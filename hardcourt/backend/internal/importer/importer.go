@@ -0,0 +1,256 @@
+// Package importer loads historical results from local dataset files into
+// the database. Files are read into a Dataset first, with players resolved
+// to the seeder's IDs and scores run through the score parser, then written
+// in one pass: tournaments, players, then matches with their sets and stats.
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/repository"
+)
+
+// RowError is a dataset row that could not be imported. Line is 0 for
+// errors about a whole match, such as a point log that does not score.
+type RowError struct {
+	File string
+	Line int
+	Err  error
+}
+
+func (e RowError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %v", e.File, e.Err)
+	}
+	return fmt.Sprintf("%s:%d: %v", e.File, e.Line, e.Err)
+}
+
+func (e RowError) Unwrap() error { return e.Err }
+
+// Dataset is everything read from one or more files, deduplicated by ID
+type Dataset struct {
+	Tournaments map[string]*domain.Tournament
+	Players     map[string]*domain.Player
+	Matches     []*domain.Match
+	PointLogs   []*PointLog
+
+	// Rows counts data rows read; Rejected lists those left out
+	Rows     int
+	Rejected []RowError
+
+	resolver *Resolver
+	matchIDs map[string]bool
+}
+
+func NewDataset(resolver *Resolver) *Dataset {
+	return &Dataset{
+		Tournaments: make(map[string]*domain.Tournament),
+		Players:     make(map[string]*domain.Player),
+		resolver:    resolver,
+		matchIDs:    make(map[string]bool),
+	}
+}
+
+// hasMatch reports whether a match ID was already read, and records it
+func (d *Dataset) hasMatch(id string) bool {
+	if d.matchIDs[id] {
+		return true
+	}
+	d.matchIDs[id] = true
+	return false
+}
+
+// Report counts what an import did
+type Report struct {
+	Files       int `json:"files"`
+	Rows        int `json:"rows"`
+	Rejected    int `json:"rejected"`
+	Tournaments int `json:"tournaments"`
+	Players     int `json:"players"`
+	Inserted    int `json:"inserted"`
+	Updated     int `json:"updated"`
+	Failed      int `json:"failed"`
+	PointLogs   int `json:"point_logs"`
+	Points      int `json:"points"`
+}
+
+// Importer writes datasets through the repositories
+type Importer struct {
+	writer    *repository.BulkWriter
+	matchRepo *repository.MatchRepository
+	pointRepo *repository.PointRepository
+	resolver  *Resolver
+}
+
+func NewImporter(
+	writer *repository.BulkWriter,
+	matchRepo *repository.MatchRepository,
+	pointRepo *repository.PointRepository,
+) *Importer {
+	return &Importer{
+		writer:    writer,
+		matchRepo: matchRepo,
+		pointRepo: pointRepo,
+		resolver:  NewResolver(),
+	}
+}
+
+// Resolver returns the player resolver, e.g. to add aliases before importing
+func (i *Importer) Resolver() *Resolver {
+	return i.resolver
+}
+
+// ImportATPResults reads ATP results CSV files and writes their matches.
+// Rejected rows are logged and counted; the import carries on without them.
+func (i *Importer) ImportATPResults(ctx context.Context, paths []string) (Report, error) {
+	return i.importFiles(ctx, paths, (*Dataset).ReadATPResults)
+}
+
+// ImportPointSequences reads point-sequence CSV files and writes each
+// match with its point log
+func (i *Importer) ImportPointSequences(ctx context.Context, paths []string) (Report, error) {
+	return i.importFiles(ctx, paths, (*Dataset).ReadPointSequences)
+}
+
+// ImportCharting reads a charting dataset's matches and points files and
+// writes each charted match with its point log
+func (i *Importer) ImportCharting(ctx context.Context, matchesPath, pointsPath string) (Report, error) {
+	dataset := NewDataset(i.resolver)
+	matches, err := os.Open(matchesPath)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to open %s: %w", matchesPath, err)
+	}
+	defer matches.Close()
+	points, err := os.Open(pointsPath)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to open %s: %w", pointsPath, err)
+	}
+	defer points.Close()
+
+	if err := dataset.ReadChartedPoints(matches, matchesPath, points, pointsPath); err != nil {
+		return Report{}, err
+	}
+	log.Printf("✓ Read %s and %s", matchesPath, pointsPath)
+
+	report, err := i.write(ctx, dataset)
+	report.Files = 2
+	return report, err
+}
+
+func (i *Importer) importFiles(ctx context.Context, paths []string, read func(*Dataset, io.Reader, string) error) (Report, error) {
+	dataset := NewDataset(i.resolver)
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return Report{}, fmt.Errorf("failed to open %s: %w", path, err)
+		}
+		err = read(dataset, f, path)
+		f.Close()
+		if err != nil {
+			return Report{}, err
+		}
+		log.Printf("✓ Read %s", path)
+	}
+
+	report, err := i.write(ctx, dataset)
+	report.Files = len(paths)
+	return report, err
+}
+
+// write logs a dataset's rejected rows and stores the rest
+func (i *Importer) write(ctx context.Context, dataset *Dataset) (Report, error) {
+	for _, rejected := range dataset.Rejected {
+		log.Printf("⚠️  Skipping %v", rejected)
+	}
+	return i.Write(ctx, dataset)
+}
+
+// Write stores a dataset. Tournaments, players and matches are written in
+// one bulk transaction, so a failed import leaves nothing behind; point logs
+// follow match by match. Matches already stored are updated, so importing
+// the same files twice changes nothing.
+func (i *Importer) Write(ctx context.Context, dataset *Dataset) (Report, error) {
+	report := Report{Rows: dataset.Rows, Rejected: len(dataset.Rejected)}
+
+	batch := &repository.BulkBatch{Matches: dataset.Matches, MergePlayers: true}
+	for _, id := range sortedKeys(dataset.Tournaments) {
+		batch.Tournaments = append(batch.Tournaments, dataset.Tournaments[id])
+	}
+	for _, id := range sortedKeys(dataset.Players) {
+		batch.Players = append(batch.Players, dataset.Players[id])
+	}
+	result, err := i.writer.Write(ctx, batch)
+	if err != nil {
+		return report, err
+	}
+	report.Tournaments = result.Tournaments
+	report.Players = result.Players
+	report.Inserted = result.MatchesInserted
+	report.Updated = result.MatchesUpdated
+
+	for _, pointLog := range dataset.PointLogs {
+		inserted, err := i.writePointLog(ctx, pointLog)
+		if err != nil {
+			if ctx.Err() != nil {
+				return report, ctx.Err()
+			}
+			log.Printf("⚠️  Failed to import point log for %s: %v", pointLog.Match.ID, err)
+			report.Failed++
+			continue
+		}
+		if inserted {
+			report.Inserted++
+		} else {
+			report.Updated++
+		}
+		report.PointLogs++
+		report.Points += len(pointLog.Points)
+	}
+
+	return report, nil
+}
+
+// sortedKeys returns a map's keys in order, so that writes are repeatable
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writePointLog stores a point log's match and replaces its points. A match
+// already stored with the players the other way round, as seeded matches
+// may be, keeps its ID and the log is swapped to fit it.
+func (i *Importer) writePointLog(ctx context.Context, pointLog *PointLog) (inserted bool, err error) {
+	match := pointLog.Match
+	_, err = i.matchRepo.GetByID(ctx, match.ID)
+	if errors.Is(err, repository.ErrMatchNotFound) {
+		reversed := matchID(match.TournamentID, match.Round, match.Player2ID, match.Player1ID)
+		if _, err = i.matchRepo.GetByID(ctx, reversed); err == nil {
+			pointLog.swap()
+			pointLog.setID(reversed)
+		}
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrMatchNotFound):
+		inserted = true
+		err = i.matchRepo.Create(ctx, match)
+	case err == nil:
+		err = i.matchRepo.Update(ctx, match)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return inserted, i.pointRepo.Replace(ctx, match.ID, pointLog.Points)
+}
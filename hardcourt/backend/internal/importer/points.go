@@ -0,0 +1,230 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/scoring"
+)
+
+// PointLog is one match's points read from a point-by-point dataset, with
+// the match they belong to. Player 1 is the winner, as in results imports.
+type PointLog struct {
+	Match  *domain.Match
+	Points []domain.Point
+}
+
+// call is one point as a source records it, before it is scored
+type call struct {
+	server    int  // Who served, 0 if the source leaves it to the rotation
+	winner    int  // Who won, 0 to use serverWon
+	serverWon bool // For sources that only say whether the server won
+
+	// Delimiters in the source, checked against the score
+	delimited bool
+	endsGame  bool
+	endsSet   bool
+
+	point domain.Point // Outcome, shots and serve filled in by the reader
+}
+
+// finalSetRules are tried in order until a match replays cleanly, since
+// few sources say how their deciding sets were played
+var finalSetRules = []scoring.FinalSet{scoring.FinalSetTiebreak, scoring.FinalSetMatchTiebreak, scoring.FinalSetAdvantage}
+
+// replay scores a match point by point. setsToWin of 0 tries best of three,
+// then best of five. It returns the points with their numbers, server and
+// score before each, and the scorer at the end.
+func replay(calls []call, setsToWin int) ([]domain.Point, *scoring.Scorer, error) {
+	if len(calls) == 0 {
+		return nil, nil, errors.New("no points")
+	}
+	options := []int{setsToWin}
+	if setsToWin == 0 {
+		options = []int{2, 3}
+	}
+
+	var firstErr error
+	for _, sets := range options {
+		for _, rule := range finalSetRules {
+			points, scorer, err := replayWith(calls, sets, rule)
+			if err == nil {
+				return points, scorer, nil
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return nil, nil, firstErr
+}
+
+func replayWith(calls []call, setsToWin int, rule scoring.FinalSet) ([]domain.Point, *scoring.Scorer, error) {
+	firstServer := calls[0].server
+	if firstServer == 0 {
+		firstServer = 1
+	}
+	scorer := scoring.NewScorer(setsToWin, firstServer, rule)
+
+	points := make([]domain.Point, len(calls))
+	for i, c := range calls {
+		state := scorer.State()
+		p := c.point
+		p.Number = i + 1
+		p.SetNumber = state.SetsP1 + state.SetsP2 + 1
+		p.GameNumber = state.GamesP1 + state.GamesP2 + 1
+		p.Server = scorer.Server()
+		p.Score = state
+		p.Tiebreak = scorer.InTiebreak()
+		p.BreakPoint = scoring.IsBreakPoint(state)
+
+		if c.server != 0 && c.server != p.Server {
+			return nil, nil, fmt.Errorf("point %d: served by player %d, the rotation says %d", p.Number, c.server, p.Server)
+		}
+		p.Winner = c.winner
+		if p.Winner == 0 {
+			p.Winner = p.Server
+			if !c.serverWon {
+				p.Winner = 3 - p.Server
+			}
+		}
+		if err := scorer.Point(p.Winner); err != nil {
+			return nil, nil, fmt.Errorf("point %d: %w", p.Number, err)
+		}
+		points[i] = p
+
+		// The last point ends the match, whatever the source marks
+		if !c.delimited || i == len(calls)-1 {
+			continue
+		}
+		after := scorer.State()
+		gameOver := after.PointsP1 == "0" && after.PointsP2 == "0"
+		setOver := gameOver && after.GamesP1 == 0 && after.GamesP2 == 0
+		if gameOver != c.endsGame || setOver != c.endsSet {
+			return nil, nil, fmt.Errorf("point %d: the source and the score disagree on where game %d of set %d ends",
+				p.Number, p.GameNumber, p.SetNumber)
+		}
+	}
+	return points, scorer, nil
+}
+
+// addPointLog scores a match's points and adds it to the dataset. winner
+// is who the source says won (1 or 2), or 0 to take it from the score.
+func (d *Dataset) addPointLog(match *domain.Match, calls []call, setsToWin, winner int, source string) error {
+	points, scorer, err := replay(calls, setsToWin)
+	if err != nil {
+		return err
+	}
+	if winner == 0 {
+		winner = scorer.Winner()
+	}
+	if winner == 0 {
+		return errors.New("points end before the match does and the source names no winner")
+	}
+	if scored := scorer.Winner(); scored != 0 && scored != winner {
+		return fmt.Errorf("points give the match to player %d, the source to player %d", scored, winner)
+	}
+
+	match.Status = domain.StatusFinished
+	match.Sets = scorer.Sets()
+	final := scorer.State()
+	match.Score = domain.ScoreState{SetsP1: final.SetsP1, SetsP2: final.SetsP2}
+	for i := range points {
+		points[i].Source = source
+	}
+
+	log := &PointLog{Match: match, Points: points}
+	if winner == 2 {
+		log.swap()
+	}
+	winnerID := log.Match.Player1ID
+	log.Match.WinnerID = &winnerID
+	log.setID(matchID(log.Match.TournamentID, log.Match.Round, log.Match.Player1ID, log.Match.Player2ID))
+
+	d.PointLogs = append(d.PointLogs, log)
+	return nil
+}
+
+// setID sets the match ID on the match and every point
+func (l *PointLog) setID(id string) {
+	l.Match.ID = id
+	for i := range l.Points {
+		l.Points[i].MatchID = id
+	}
+}
+
+// swap exchanges players 1 and 2 in the match and every point
+func (l *PointLog) swap() {
+	m := l.Match
+	m.Player1ID, m.Player2ID = m.Player2ID, m.Player1ID
+	m.Player1, m.Player2 = m.Player2, m.Player1
+	m.SeedP1, m.SeedP2 = m.SeedP2, m.SeedP1
+	m.Score.SetsP1, m.Score.SetsP2 = m.Score.SetsP2, m.Score.SetsP1
+	for i, set := range m.Sets {
+		m.Sets[i].GamesP1, m.Sets[i].GamesP2 = set.GamesP2, set.GamesP1
+		m.Sets[i].TiebreakP1, m.Sets[i].TiebreakP2 = set.TiebreakP2, set.TiebreakP1
+	}
+	for i := range l.Points {
+		l.Points[i] = swapPoint(l.Points[i])
+	}
+}
+
+func swapPoint(p domain.Point) domain.Point {
+	p.Server, p.Winner = 3-p.Server, 3-p.Winner
+	s := p.Score
+	p.Score = domain.ScoreState{
+		SetsP1: s.SetsP2, SetsP2: s.SetsP1,
+		GamesP1: s.GamesP2, GamesP2: s.GamesP1,
+		PointsP1: s.PointsP2, PointsP2: s.PointsP1,
+		Serving: p.Server,
+	}
+	return p
+}
+
+// pointLogPlayer resolves a player named in a point-by-point file, which
+// carries no player IDs
+func (d *Dataset) pointLogPlayer(name, hand string) *domain.Player {
+	id, display := d.resolver.Resolve("", name)
+	p, ok := d.Players[id]
+	if !ok {
+		p = &domain.Player{ID: id, Name: display, CountryCode: "XX"}
+		d.Players[id] = p
+	}
+	if p.Plays == "" {
+		switch hand {
+		case "R":
+			p.Plays = "Right"
+		case "L":
+			p.Plays = "Left"
+		}
+	}
+	return p
+}
+
+// pointLogMatch starts the match a point log belongs to
+func pointLogMatch(tournament *domain.Tournament, date time.Time, round string, p1, p2 *domain.Player) *domain.Match {
+	return &domain.Match{
+		TournamentID: tournament.ID,
+		Tournament:   tournament,
+		Player1ID:    p1.ID,
+		Player2ID:    p2.ID,
+		Player1:      p1,
+		Player2:      p2,
+		Round:        round,
+		StartTime:    date,
+	}
+}
+
+// tournament returns the dataset's tournament with the given name and
+// year, adding a bare one on first sight
+func (d *Dataset) tournament(name string, date time.Time, surface string) *domain.Tournament {
+	id := tournamentID(name, date.Year())
+	if t, ok := d.Tournaments[id]; ok {
+		return t
+	}
+	t := &domain.Tournament{ID: id, Name: name, Surface: surface, Year: date.Year()}
+	d.Tournaments[id] = t
+	return t
+}
@@ -0,0 +1,129 @@
+package importer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/scoring"
+)
+
+func TestReadPointSequences(t *testing.T) {
+	f, err := os.Open("testdata/pbp_matches_2024.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	dataset := NewDataset(NewResolver())
+	if err := dataset.ReadPointSequences(f, "pbp_matches_2024.csv"); err != nil {
+		t.Fatalf("ReadPointSequences failed: %v", err)
+	}
+
+	// A set delimiter after one game does not match the score
+	if len(dataset.PointLogs) != 2 || len(dataset.Rejected) != 1 || dataset.Rejected[0].Line != 4 {
+		t.Fatalf("Expected 2 point logs and row 4 rejected, got %d and %v", len(dataset.PointLogs), dataset.Rejected)
+	}
+
+	// The winner is player 1 and the ID matches the seed data's
+	final := dataset.PointLogs[0]
+	if final.Match.ID != "aus-open-2024-j-sinner-vs-d-medvedev" || *final.Match.WinnerID != "j-sinner" {
+		t.Errorf("Unexpected match %s won by %v", final.Match.ID, *final.Match.WinnerID)
+	}
+	if len(final.Points) != 48 || scoring.FormatSets(final.Match.Sets) != "6-0 6-0" {
+		t.Errorf("Expected 48 points and 6-0 6-0, got %d and %s", len(final.Points), scoring.FormatSets(final.Match.Sets))
+	}
+	first, second := final.Points[0], final.Points[4]
+	if first.Server != 2 || first.Winner != 1 || first.MatchID != final.Match.ID {
+		t.Errorf("Expected Medvedev, now player 2, to serve and lose the first point, got %+v", first)
+	}
+	if final.Points[3].Outcome != domain.PointDoubleFault || second.Outcome != domain.PointAce || second.Server != 1 {
+		t.Errorf("Expected a double fault then a Sinner ace, got %+v and %+v", final.Points[3], second)
+	}
+	if !final.Points[3].BreakPoint {
+		t.Error("Expected 0-40 on Medvedev's serve to be a break point")
+	}
+
+	// Tiebreak points are scored as counts and the set records them
+	tiebreak := dataset.PointLogs[1]
+	if scoring.FormatSets(tiebreak.Match.Sets) != "7-6(0) 6-0" || tiebreak.Match.DurationMinutes != 95 {
+		t.Errorf("Unexpected tiebreak match: %s in %d minutes", scoring.FormatSets(tiebreak.Match.Sets), tiebreak.Match.DurationMinutes)
+	}
+	tb := tiebreak.Points[49]
+	if !tb.Tiebreak || tb.Server != 2 || tb.Score.PointsP1 != "1" || tb.Score.PointsP2 != "0" {
+		t.Errorf("Expected the second tiebreak point served by player 2 at 1-0, got %+v", tb)
+	}
+}
+
+func TestReadChartedPoints(t *testing.T) {
+	matches := "match_id,Player 1,Player 2,Pl 1 hand,Pl 2 hand,Date,Tournament,Round,Time,Court,Surface,Umpire,Best of\n" +
+		"20240317-M-Indian_Wells_Masters-F-Carlos_Alcaraz-Daniil_Medvedev,Carlos Alcaraz,Daniil Medvedev,R,R,20240317,Indian Wells Masters,F,,,Hard,,3\n" +
+		"20240318-M-Indian_Wells_Masters-F-Carlos_Alcaraz-Jannik_Sinner,Carlos Alcaraz,Jannik Sinner,R,R,20240318,Indian Wells Masters,F,,,Hard,,3\n"
+
+	// Alcaraz wins every point of a 6-0 6-0, serving first
+	var points strings.Builder
+	points.WriteString("match_id,Pt,Set1,Set2,Gm1,Gm2,Pts,Gm#,TbSet,Svr,1st,2nd,PtWinner\n")
+	serves := [][2]string{{"4*", ""}, {"6n", "5f2b3*"}, {"6f1n@", ""}, {"4#", ""}}
+	for pt := 1; pt <= 48; pt++ {
+		server := ((pt-1)/4)%2 + 1
+		serve := serves[(pt-1)%4]
+		if server == 2 {
+			serve = [2]string{"4d", "5w"}
+		}
+		fmt.Fprintf(&points, "20240317-M-Indian_Wells_Masters-F-Carlos_Alcaraz-Daniil_Medvedev,%d,,,,,,,,%d,%s,%s,1\n", pt, server, serve[0], serve[1])
+	}
+
+	dataset := NewDataset(NewResolver())
+	err := dataset.ReadChartedPoints(strings.NewReader(matches), "charting-m-matches.csv", strings.NewReader(points.String()), "charting-m-points.csv")
+	if err != nil {
+		t.Fatalf("ReadChartedPoints failed: %v", err)
+	}
+
+	// The match without points is left out
+	if len(dataset.PointLogs) != 1 || len(dataset.Rejected) != 0 {
+		t.Fatalf("Expected 1 point log and nothing rejected, got %d and %v", len(dataset.PointLogs), dataset.Rejected)
+	}
+	log := dataset.PointLogs[0]
+	if log.Match.ID != "indian-wells-2024-c-alcaraz-vs-d-medvedev" || log.Match.Round != "F" || scoring.FormatSets(log.Match.Sets) != "6-0 6-0" {
+		t.Errorf("Unexpected match %s, round %s, score %s", log.Match.ID, log.Match.Round, scoring.FormatSets(log.Match.Sets))
+	}
+	if dataset.Players["c-alcaraz"].Plays != "Right" || dataset.Tournaments["indian-wells-2024"].Surface != "Hard" {
+		t.Error("Expected hand and surface from the matches file")
+	}
+
+	tests := []struct {
+		point   int
+		outcome domain.PointOutcome
+		second  bool
+		rally   int
+		shots   string
+	}{
+		{0, domain.PointAce, false, 1, "4*"},
+		{1, domain.PointWinner, true, 3, "6n 5f2b3*"},
+		{2, domain.PointUnforcedError, false, 2, "6f1n@"},
+		{3, domain.PointServeWinner, false, 1, "4#"},
+		{4, domain.PointDoubleFault, true, 0, "4d 5w"},
+	}
+	for _, tt := range tests {
+		p := log.Points[tt.point]
+		if p.Outcome != tt.outcome || p.SecondServe != tt.second || p.RallyLength != tt.rally || p.Shots != tt.shots {
+			t.Errorf("Point %d: expected %s, second serve %v, rally %d, %q; got %+v", tt.point+1, tt.outcome, tt.second, tt.rally, tt.shots, p)
+		}
+	}
+}
+
+func TestReadChartedPoints_WrongServer(t *testing.T) {
+	matches := "match_id,Player 1,Player 2,Date,Tournament\nm1,Carlos Alcaraz,Daniil Medvedev,20240317,Indian Wells Masters\n"
+	points := "match_id,Pt,Svr,1st,2nd,PtWinner\nm1,1,1,4*,,1\nm1,2,2,4*,,2\n"
+
+	dataset := NewDataset(NewResolver())
+	err := dataset.ReadChartedPoints(strings.NewReader(matches), "matches.csv", strings.NewReader(points), "points.csv")
+	if err != nil {
+		t.Fatalf("ReadChartedPoints failed: %v", err)
+	}
+	if len(dataset.PointLogs) != 0 || len(dataset.Rejected) != 1 || !strings.Contains(dataset.Rejected[0].Error(), "the rotation says 1") {
+		t.Errorf("Expected the match rejected for a serve out of turn, got %v", dataset.Rejected)
+	}
+}
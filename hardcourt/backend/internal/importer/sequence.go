@@ -0,0 +1,101 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// SourcePointSequence marks points read from point-sequence files
+const SourcePointSequence = "pbp"
+
+// sequenceColumns must be in every point-sequence file
+var sequenceColumns = []string{"date", "tny_name", "server1", "server2", "winner", "pbp"}
+
+// sequenceDateLayouts are the date formats point-sequence files use
+var sequenceDateLayouts = []string{"02 Jan 06", "2006-01-02", "20060102"}
+
+// ReadPointSequences adds a point-sequence CSV to the dataset, one match per
+// row. The pbp column spells out every point from the server's side:
+//
+//	S  server won        A  ace
+//	R  receiver won      D  double fault
+//	;  end of game       .  end of set
+//	/  change of serve inside a tiebreak
+//
+// server1 served first and winner names the match winner as 1 or 2.
+func (d *Dataset) ReadPointSequences(r io.Reader, file string) error {
+	return d.readCSV(r, file, "a point-sequence", sequenceColumns, d.addSequenceRow)
+}
+
+func (d *Dataset) addSequenceRow(row csvRow) error {
+	date, err := parseSequenceDate(row.get("date"))
+	if err != nil {
+		return err
+	}
+	if row.get("server1") == "" || row.get("server2") == "" {
+		return errors.New("missing player name")
+	}
+	winner, _ := strconv.Atoi(row.get("winner"))
+	if winner != 1 && winner != 2 {
+		return fmt.Errorf("winner must be 1 or 2, got %q", row.get("winner"))
+	}
+	calls, err := parseSequence(row.get("pbp"))
+	if err != nil {
+		return err
+	}
+
+	tournament := d.tournament(row.get("tny_name"), date, "")
+	p1 := d.pointLogPlayer(row.get("server1"), "")
+	p2 := d.pointLogPlayer(row.get("server2"), "")
+	match := pointLogMatch(tournament, date, "", p1, p2)
+	match.DurationMinutes = row.int("wh_minutes")
+
+	return d.addPointLog(match, calls, 0, winner, SourcePointSequence)
+}
+
+func parseSequenceDate(text string) (time.Time, error) {
+	for _, layout := range sequenceDateLayouts {
+		if date, err := time.Parse(layout, text); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", text)
+}
+
+// parseSequence reads a pbp string into points, player 1 serving first
+func parseSequence(pbp string) ([]call, error) {
+	var calls []call
+	for i, c := range pbp {
+		switch c {
+		case 'S', 'A', 'R', 'D':
+			point := call{serverWon: c == 'S' || c == 'A', delimited: true}
+			switch c {
+			case 'A':
+				point.point.Outcome = domain.PointAce
+			case 'D':
+				point.point.Outcome = domain.PointDoubleFault
+				point.point.SecondServe = true
+			}
+			calls = append(calls, point)
+		case ';', '.':
+			if len(calls) == 0 || calls[len(calls)-1].endsGame {
+				return nil, fmt.Errorf("empty game at position %d of the sequence", i+1)
+			}
+			calls[len(calls)-1].endsGame = true
+			calls[len(calls)-1].endsSet = c == '.'
+		case '/', ' ':
+			// Serve changes are worked out by the scorer
+		default:
+			return nil, fmt.Errorf("unknown point %q at position %d of the sequence", c, i+1)
+		}
+	}
+	if len(calls) == 0 {
+		return nil, errors.New("no points")
+	}
+	return calls, nil
+}
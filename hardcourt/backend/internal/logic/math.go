@@ -2,6 +2,9 @@ package logic
 
 import (
 	"math"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/scoring"
 )
 
 // MathEngine handles the "Moneyball" statistics
@@ -16,7 +19,14 @@ func NewMathEngine() *MathEngine {
 // Here we use a deterministic approximation based on score delta and base strength.
 func (m *MathEngine) CalculateWinProbability(setsP1, setsP2, gamesP1, gamesP2 int, pointsP1, pointsP2 string, server int) float64 {
 	// Base probability (assume 50/50 start)
-	prob := 0.5
+	return m.CalculateWinProbabilityFrom(0.5, setsP1, setsP2, gamesP1, gamesP2, pointsP1, pointsP2, server)
+}
+
+// CalculateWinProbabilityFrom is CalculateWinProbability for players who are
+// not evenly matched: base is player 1's chance before the first point,
+// e.g. from PreMatchWinProbability
+func (m *MathEngine) CalculateWinProbabilityFrom(base float64, setsP1, setsP2, gamesP1, gamesP2 int, pointsP1, pointsP2 string, server int) float64 {
+	prob := base
 
 	// Weight factors
 	const (
@@ -53,6 +63,25 @@ func (m *MathEngine) CalculateWinProbability(setsP1, setsP2, gamesP1, gamesP2 in
 	return prob
 }
 
+// unrankedRank is the ranking RankRating assumes for unranked players
+const unrankedRank = 300
+
+// RankRating turns an ATP ranking into an Elo-style rating for
+// PreMatchWinProbability: halving the rank is worth about 70 points, so
+// No. 1 beats No. 2 60% of the time and No. 100 about 7% of the time
+func RankRating(rank int) float64 {
+	if rank <= 0 {
+		rank = unrankedRank
+	}
+	return 2000 - 100*math.Log(float64(rank))
+}
+
+// PreMatchWinProbability is player 1's chance of beating player 2 before a
+// point is played, from their ratings
+func (m *MathEngine) PreMatchWinProbability(ratingP1, ratingP2 float64) float64 {
+	return 1 / (1 + math.Pow(10, (ratingP2-ratingP1)/400))
+}
+
 func pointToValue(p string) int {
 	switch p {
 	case "0":
@@ -92,6 +121,19 @@ func (m *MathEngine) CalculateLeverage(currentProb float64, isBreakPoint bool, i
 	return baseLeverage * (0.5 + 0.5*uncertainty)
 }
 
+// MatchLeverage is CalculateLeverage at a match's current score, for feeds
+// that report the score but not how much the next point matters
+func (m *MathEngine) MatchLeverage(match *domain.Match) float64 {
+	s := match.Score
+	setsToWin := scoring.SetsToWin(match)
+	prob := m.CalculateWinProbability(s.SetsP1, s.SetsP2, s.GamesP1, s.GamesP2, s.PointsP1, s.PointsP2, s.Serving)
+	return m.CalculateLeverage(prob,
+		scoring.IsBreakPoint(s),
+		scoring.IsSetPoint(s, 1) || scoring.IsSetPoint(s, 2),
+		scoring.IsMatchPoint(s, 1, setsToWin) || scoring.IsMatchPoint(s, 2, setsToWin),
+	)
+}
+
 // CalculateFatigue Linear decay based on rally count and time
 func (m *MathEngine) CalculateFatigue(currentFatigue float64, rallyLength int) float64 {
 	// Recovery
@@ -0,0 +1,60 @@
+package providers
+
+import (
+	"net/http"
+	"time"
+)
+
+// HTTPConfig is how a provider reaches its feed
+type HTTPConfig struct {
+	BaseURL string
+	Client  *http.Client
+
+	// Now is the provider's clock, time.Now unless replaying a recorded day
+	Now func() time.Time
+}
+
+// Option overrides part of a provider's HTTPConfig, e.g. to point it at a
+// test server
+type Option func(*HTTPConfig)
+
+// WithBaseURL replaces the feed's scheme and host, plus any path prefix
+func WithBaseURL(baseURL string) Option {
+	return func(c *HTTPConfig) { c.BaseURL = baseURL }
+}
+
+// WithHTTPClient replaces the HTTP client used for every request
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *HTTPConfig) { c.Client = client }
+}
+
+// WithTransport wraps the HTTP client's transport, e.g. to record or
+// replay traffic. It applies to whichever client is configured when it runs,
+// so pass it after WithHTTPClient.
+func WithTransport(wrap func(http.RoundTripper) http.RoundTripper) Option {
+	return func(c *HTTPConfig) {
+		client := *c.Client
+		next := client.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		client.Transport = wrap(next)
+		c.Client = &client
+	}
+}
+
+// WithClock replaces the clock a provider dates its requests and matches by
+func WithClock(now func() time.Time) Option {
+	return func(c *HTTPConfig) { c.Now = now }
+}
+
+// ApplyOptions returns defaults with opts applied in order
+func ApplyOptions(defaults HTTPConfig, opts []Option) HTTPConfig {
+	for _, opt := range opts {
+		opt(&defaults)
+	}
+	if defaults.Now == nil {
+		defaults.Now = time.Now
+	}
+	return defaults
+}
@@ -0,0 +1,159 @@
+package providers
+
+import (
+	"time"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/logic"
+	"hardcourt/backend/internal/scoring"
+)
+
+// Live polling adapts to what is being played: critical matches are polled
+// faster than the configured interval, idle tours slower, and an empty
+// schedule backs off to OvernightInterval.
+const (
+	// MinInterval is the fastest a critical match speeds polling up to
+	MinInterval = 5 * time.Second
+
+	// FastFactor divides the interval while a live match is critical
+	FastFactor = 4
+
+	// IdleFactor multiplies the interval while nothing is live
+	IdleFactor = 4
+
+	// OvernightInterval is used when nothing is live or due to start soon
+	OvernightInterval = 15 * time.Minute
+
+	// OvernightAfter is how far away the next scheduled start must be to back off overnight
+	OvernightAfter = time.Hour
+
+	// StaleSchedule is how late a scheduled match can be before it is ignored
+	StaleSchedule = 6 * time.Hour
+
+	// CriticalLeverage marks a point as critical, e.g. a break point in a close match
+	CriticalLeverage = 0.25
+)
+
+// Activity is what a provider last reported, used to pace its live polls
+type Activity struct {
+	// Live matches from the last live poll
+	Live []*domain.Match
+
+	// ScheduleKnown is set once a provider has returned a schedule
+	ScheduleKnown bool
+
+	// NextStart is the earliest scheduled match still to start, zero if none
+	NextStart time.Time
+}
+
+var engine = logic.NewMathEngine()
+
+// Critical reports whether a live match is at a moment worth polling fast.
+// Feeds other than the simulator leave LeverageIndex at zero, so it is
+// worked out from the score for them.
+func Critical(m *domain.Match) bool {
+	if m.Status != domain.StatusLive {
+		return false
+	}
+	leverage := m.LeverageIndex
+	if leverage == 0 {
+		leverage = engine.MatchLeverage(m)
+	}
+	return leverage >= CriticalLeverage || scoring.NearMatchEnd(m.Score, scoring.SetsToWin(m))
+}
+
+// Mode names the pace LiveInterval picked, for status output
+func (a Activity) Mode(now time.Time) string {
+	switch {
+	case a.critical():
+		return "critical"
+	case a.live():
+		return "live"
+	case a.overnight(now):
+		return "overnight"
+	default:
+		return "idle"
+	}
+}
+
+// LiveInterval returns how long to wait before the next live poll
+func (a Activity) LiveInterval(base time.Duration, now time.Time) time.Duration {
+	switch a.Mode(now) {
+	case "critical":
+		return maxDuration(base/FastFactor, minDuration(base, MinInterval))
+	case "live":
+		return base
+	}
+
+	wait := base * IdleFactor
+	if a.overnight(now) {
+		wait = maxDuration(wait, OvernightInterval)
+	}
+	// Wake up in time for the next scheduled start
+	if !a.NextStart.IsZero() {
+		if untilStart := a.NextStart.Sub(now); untilStart < wait {
+			wait = untilStart
+		}
+	}
+	return maxDuration(wait, base)
+}
+
+func (a Activity) live() bool {
+	for _, m := range a.Live {
+		if m.Status == domain.StatusLive {
+			return true
+		}
+	}
+	return false
+}
+
+func (a Activity) critical() bool {
+	for _, m := range a.Live {
+		if Critical(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// overnight reports an empty schedule: nothing left today, or nothing for a while
+func (a Activity) overnight(now time.Time) bool {
+	if !a.ScheduleKnown {
+		return false
+	}
+	return a.NextStart.IsZero() || a.NextStart.Sub(now) > OvernightAfter
+}
+
+// NextStart returns the earliest start among scheduled matches. Matches
+// running late count as starting now; ones more than StaleSchedule overdue
+// are assumed cancelled.
+func NextStart(matches []*domain.Match, now time.Time) time.Time {
+	var next time.Time
+	for _, m := range matches {
+		if m.Status != domain.StatusScheduled || now.Sub(m.StartTime) > StaleSchedule {
+			continue
+		}
+		start := m.StartTime
+		if start.Before(now) {
+			start = now
+		}
+		if next.IsZero() || start.Before(next) {
+			next = start
+		}
+	}
+	return next
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,140 @@
+package providers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+func TestActivity_LiveInterval(t *testing.T) {
+	now := time.Date(2025, 1, 26, 8, 30, 0, 0, time.UTC)
+	base := 30 * time.Second
+
+	quiet := &domain.Match{ID: "m1", Status: domain.StatusLive, Score: domain.ScoreState{GamesP1: 2, GamesP2: 1}}
+	breakPoint := &domain.Match{ID: "m2", Status: domain.StatusLive, LeverageIndex: 0.3}
+	// A provider's match: the leverage comes from the score, 30-40 on serve
+	feedBreakPoint := &domain.Match{ID: "m4", Status: domain.StatusLive, Score: domain.ScoreState{
+		GamesP1: 3, GamesP2: 3, PointsP1: "30", PointsP2: "40", Serving: 1,
+	}}
+	decider := &domain.Match{ID: "m3", Status: domain.StatusLive, Score: domain.ScoreState{SetsP1: 1, SetsP2: 1, GamesP1: 4, GamesP2: 4}}
+
+	tests := []struct {
+		name     string
+		activity Activity
+		mode     string
+		want     time.Duration
+	}{
+		{"live", Activity{Live: []*domain.Match{quiet}}, "live", base},
+		{"high leverage", Activity{Live: []*domain.Match{quiet, breakPoint}}, "critical", base / FastFactor},
+		{"close to match point", Activity{Live: []*domain.Match{decider}}, "critical", base / FastFactor},
+		{"break point from the score", Activity{Live: []*domain.Match{quiet, feedBreakPoint}}, "critical", base / FastFactor},
+		{"idle without a schedule", Activity{}, "idle", base * IdleFactor},
+		{"idle before a start", Activity{ScheduleKnown: true, NextStart: now.Add(30 * time.Minute)}, "idle", base * IdleFactor},
+		{"start imminent", Activity{ScheduleKnown: true, NextStart: now.Add(45 * time.Second)}, "idle", 45 * time.Second},
+		{"empty schedule", Activity{ScheduleKnown: true}, "overnight", OvernightInterval},
+		{"next start tomorrow", Activity{ScheduleKnown: true, NextStart: now.Add(10 * time.Hour)}, "overnight", OvernightInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if mode := tt.activity.Mode(now); mode != tt.mode {
+				t.Errorf("Mode = %s, want %s", mode, tt.mode)
+			}
+			if got := tt.activity.LiveInterval(base, now); got != tt.want {
+				t.Errorf("LiveInterval = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Critical polling never drops below MinInterval
+	fast := Activity{Live: []*domain.Match{breakPoint}}
+	if got := fast.LiveInterval(10*time.Second, now); got != MinInterval {
+		t.Errorf("Expected critical polling to stop at %v, got %v", MinInterval, got)
+	}
+}
+
+func TestNextStart(t *testing.T) {
+	now := time.Date(2025, 1, 26, 8, 30, 0, 0, time.UTC)
+	matches := []*domain.Match{
+		{ID: "done", Status: domain.StatusFinished, StartTime: now.Add(-time.Hour)},
+		{ID: "later", Status: domain.StatusScheduled, StartTime: now.Add(3 * time.Hour)},
+		{ID: "soon", Status: domain.StatusScheduled, StartTime: now.Add(time.Hour)},
+		{ID: "cancelled", Status: domain.StatusScheduled, StartTime: now.Add(-12 * time.Hour)},
+	}
+	if got := NextStart(matches, now); !got.Equal(now.Add(time.Hour)) {
+		t.Errorf("NextStart = %v, want %v", got, now.Add(time.Hour))
+	}
+
+	// A match running late is due now
+	matches = append(matches, &domain.Match{ID: "late", Status: domain.StatusScheduled, StartTime: now.Add(-20 * time.Minute)})
+	if got := NextStart(matches, now); !got.Equal(now) {
+		t.Errorf("Expected a late match to be due now, got %v", got)
+	}
+}
+
+func TestScheduler_LiveBudget(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&fakeProvider{name: "fake"}, Config{Interval: time.Second, HourlyBudget: 60})
+	scheduler := NewScheduler(registry, &recordingSink{matches: make(map[string]int)})
+
+	entry := registry.Enabled()[0]
+	scheduler.budgets["fake"] = newBudget(entry.Config.HourlyBudget)
+	scheduler.live["fake"] = []*domain.Match{{ID: "m1", Status: domain.StatusLive}}
+
+	// The burst covers the first polls; after that the budget sets the pace
+	for i := 0; i < catalogCalls; i++ {
+		if wait := scheduler.nextLive(entry); wait > 2*time.Second {
+			t.Fatalf("Poll %d: expected the live interval, got %v", i+1, wait)
+		}
+	}
+	if wait := scheduler.nextLive(entry); wait < 55*time.Second {
+		t.Errorf("Expected a spent budget to hold the next poll back about a minute, got %v", wait)
+	}
+}
+
+func TestScheduler_ChargesEveryCall(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&fakeProvider{name: "fake"}, Config{Interval: time.Second, HourlyBudget: 60})
+	scheduler := NewScheduler(registry, &recordingSink{matches: make(map[string]int)})
+
+	entry := registry.Enabled()[0]
+	calls := NewCallCounter()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	client := &http.Client{Transport: calls.Wrap(http.DefaultTransport)}
+
+	scheduler.budgets["fake"] = newBudget(entry.Config.HourlyBudget)
+	scheduler.calls["fake"] = calls.Count
+	scheduler.live["fake"] = []*domain.Match{{ID: "m1", Status: domain.StatusLive}}
+
+	// One live poll that also fetched statistics: six requests
+	if wait := scheduler.nextLive(entry); wait > 2*time.Second {
+		t.Fatalf("Expected the first poll within the burst, got %v", wait)
+	}
+	for i := 0; i < 6; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if calls.Count() != 6 {
+		t.Fatalf("Expected 6 requests counted, got %d", calls.Count())
+	}
+	scheduler.charge("fake", 1)
+
+	// The five unpaid requests spend the rest of the burst and two minutes
+	// more, so the next poll waits for its own call after those
+	if wait := scheduler.nextLive(entry); wait < 175*time.Second {
+		t.Errorf("Expected the extra requests to hold the next poll back about three minutes, got %v", wait)
+	}
+
+	// Calls are only charged once
+	scheduler.charge("fake", 0)
+	if scheduler.charged["fake"] != 6 {
+		t.Errorf("Expected 6 calls charged, got %d", scheduler.charged["fake"])
+	}
+}
@@ -0,0 +1,53 @@
+// Package providers defines the interface every tennis data feed implements,
+// plus the registry and scheduler that poll them.
+package providers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// ErrUnsupported is returned by providers for capabilities their feed lacks.
+// The scheduler skips these silently.
+var ErrUnsupported = errors.New("not supported by provider")
+
+// Provider is a source of tennis data. Implementations return domain
+// objects and never write to the database; the scheduler hands results to a
+// Sink.
+type Provider interface {
+	// Name identifies the provider in config and status output
+	Name() string
+
+	// LiveMatches returns matches currently in progress
+	LiveMatches(ctx context.Context) ([]*domain.Match, error)
+
+	// ScheduledMatches returns every match scheduled on the given day
+	ScheduledMatches(ctx context.Context, day time.Time) ([]*domain.Match, error)
+
+	// Rankings returns current singles rankings
+	Rankings(ctx context.Context) ([]*domain.Player, error)
+
+	// Tournaments returns current and upcoming tournaments
+	Tournaments(ctx context.Context) ([]*domain.Tournament, error)
+
+	// MatchDetail returns one match, including stats where available
+	MatchDetail(ctx context.Context, matchID string) (*domain.Match, error)
+}
+
+// Sink receives everything the scheduler fetches. Each method reports what
+// it stored and failed to store; the error describes the failures.
+type Sink interface {
+	IngestMatches(ctx context.Context, source string, matches []*domain.Match) (Ingested, error)
+	IngestPlayers(ctx context.Context, source string, players []*domain.Player) (Ingested, error)
+	IngestTournaments(ctx context.Context, source string, tournaments []*domain.Tournament) (Ingested, error)
+}
+
+// Ingested counts the records of a batch a sink stored and failed to store.
+// Records it skipped, like matches without a tournament, count as neither.
+type Ingested struct {
+	Stored int
+	Failed int
+}
@@ -0,0 +1,79 @@
+package providers
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// hostLimiter spaces out requests to each host it sees
+type hostLimiter struct {
+	next     http.RoundTripper
+	every    time.Duration
+	burst    int
+	limiters map[string]*rate.Limiter // shared by every transport the wrapper built
+	shared   *sync.Mutex
+}
+
+// LimitHosts returns a transport wrapper that allows one request per every
+// to each host, with bursts of up to burst requests. Use it with
+// WithTransport so providers sharing a host share its budget.
+func LimitHosts(every time.Duration, burst int) func(http.RoundTripper) http.RoundTripper {
+	limiters := make(map[string]*rate.Limiter)
+	var mu sync.Mutex
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &hostLimiter{next: next, every: every, burst: burst, limiters: limiters, shared: &mu}
+	}
+}
+
+// RoundTrip waits for the host's limiter before sending the request
+func (t *hostLimiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter(req.URL.Host).Wait(req.Context()); err != nil {
+		return nil, fmt.Errorf("failed to wait for %s rate limit: %w", req.URL.Host, err)
+	}
+	return t.next.RoundTrip(req)
+}
+
+func (t *hostLimiter) limiter(host string) *rate.Limiter {
+	t.shared.Lock()
+	defer t.shared.Unlock()
+	l, ok := t.limiters[host]
+	if !ok {
+		l = rate.NewLimiter(rate.Every(t.every), t.burst)
+		t.limiters[host] = l
+	}
+	return l
+}
+
+// CallCounter counts the HTTP requests a provider sends, so the scheduler
+// can charge its budget for every one, however many a single provider call
+// makes. Use Wrap with WithTransport and set it as the provider's
+// Config.Calls.
+type CallCounter struct {
+	n atomic.Int64
+}
+
+func NewCallCounter() *CallCounter {
+	return &CallCounter{}
+}
+
+// Wrap returns a transport that counts each request before sending it
+func (c *CallCounter) Wrap(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		c.n.Add(1)
+		return next.RoundTrip(req)
+	})
+}
+
+// Count returns how many requests have been sent
+func (c *CallCounter) Count() int64 {
+	return c.n.Load()
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
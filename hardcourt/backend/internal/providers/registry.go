@@ -0,0 +1,167 @@
+package providers
+
+import (
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Defaults when a provider is registered without them
+const (
+	DefaultInterval        = 1 * time.Minute
+	DefaultCatalogInterval = 6 * time.Hour
+	DefaultHourlyBudget    = 360
+)
+
+// Config controls how often a provider is polled
+type Config struct {
+	// Interval between live match polls. Polling speeds up for critical
+	// matches and slows down when nothing is being played.
+	Interval time.Duration
+
+	// HourlyBudget caps provider calls per hour, however fast polling gets
+	HourlyBudget int
+
+	// Interval between rankings, tournaments and schedule polls
+	CatalogInterval time.Duration
+
+	// Disabled providers stay registered but are not polled
+	Disabled bool
+
+	// Resilience sets retries and the circuit breaker for every call
+	Resilience Resilience
+
+	// Calls counts the provider's HTTP requests against HourlyBudget. Without
+	// it every attempt the provider's Guard makes counts as one.
+	Calls *CallCounter
+}
+
+// Entry is a registered provider with its config
+type Entry struct {
+	Provider Provider
+	Config   Config
+}
+
+// Registry holds the available providers in registration order
+type Registry struct {
+	mu      sync.RWMutex
+	entries []*Entry
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds a provider, filling in default intervals and any zero
+// Resilience field from DefaultResilience
+func (r *Registry) Register(p Provider, cfg Config) {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.CatalogInterval <= 0 {
+		cfg.CatalogInterval = DefaultCatalogInterval
+	}
+	if cfg.HourlyBudget <= 0 {
+		cfg.HourlyBudget = DefaultHourlyBudget
+	}
+	cfg.Resilience = cfg.Resilience.withDefaults()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, &Entry{Provider: p, Config: cfg})
+}
+
+// Configure applies environment overrides:
+//
+//	PROVIDERS=sofascore,atp                 only poll the listed providers
+//	PROVIDER_SOFASCORE_INTERVAL=15s         live poll interval
+//	PROVIDER_ATP_CATALOG_INTERVAL=12h       rankings/tournaments/schedule interval
+//	PROVIDER_ATP_BUDGET=120                 provider calls allowed per hour
+//	PROVIDER_ATP_RETRIES=3                  retries per failed call
+//	PROVIDER_ATP_BREAKER_COOLDOWN=5m        how long an open breaker rejects calls
+func (r *Registry) Configure(getenv func(string) string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var enabled map[string]bool
+	if list := getenv("PROVIDERS"); list != "" {
+		enabled = make(map[string]bool)
+		for _, name := range strings.Split(list, ",") {
+			enabled[strings.ToLower(strings.TrimSpace(name))] = true
+		}
+	}
+
+	for _, entry := range r.entries {
+		name := entry.Provider.Name()
+		if enabled != nil {
+			entry.Config.Disabled = !enabled[name]
+		}
+
+		prefix := "PROVIDER_" + strings.ToUpper(name) + "_"
+		if d, ok := parseDuration(getenv, prefix+"INTERVAL"); ok {
+			entry.Config.Interval = d
+		}
+		if d, ok := parseDuration(getenv, prefix+"CATALOG_INTERVAL"); ok {
+			entry.Config.CatalogInterval = d
+		}
+		if value := getenv(prefix + "BUDGET"); value != "" {
+			if n, err := strconv.Atoi(value); err == nil && n > 0 {
+				entry.Config.HourlyBudget = n
+			} else {
+				log.Printf("Ignoring invalid %sBUDGET=%q", prefix, value)
+			}
+		}
+		if value := getenv(prefix + "RETRIES"); value != "" {
+			if n, err := strconv.Atoi(value); err == nil && n >= 0 {
+				entry.Config.Resilience.Retries = RetryCount(n)
+			} else {
+				log.Printf("Ignoring invalid %sRETRIES=%q", prefix, value)
+			}
+		}
+		if d, ok := parseDuration(getenv, prefix+"BREAKER_COOLDOWN"); ok {
+			entry.Config.Resilience.Cooldown = d
+		}
+	}
+}
+
+func parseDuration(getenv func(string) string, key string) (time.Duration, bool) {
+	value := getenv(key)
+	if value == "" {
+		return 0, false
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Printf("Ignoring invalid %s=%q", key, value)
+		return 0, false
+	}
+	return d, true
+}
+
+// Get returns the provider registered under name
+func (r *Registry) Get(name string) (Provider, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, entry := range r.entries {
+		if entry.Provider.Name() == name {
+			return entry.Provider, true
+		}
+	}
+	return nil, false
+}
+
+// Enabled returns the providers that should be polled
+func (r *Registry) Enabled() []Entry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var entries []Entry
+	for _, entry := range r.entries {
+		if !entry.Config.Disabled {
+			entries = append(entries, *entry)
+		}
+	}
+	return entries
+}
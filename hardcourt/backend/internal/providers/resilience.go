@@ -0,0 +1,316 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// ErrCircuitOpen is returned without calling the provider while its circuit
+// breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// Circuit breaker states
+const (
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half-open"
+)
+
+// Resilience controls retries and the circuit breaker around a provider
+type Resilience struct {
+	// Retries is how many times a failed call is repeated; nil leaves it
+	// unset, so an explicit 0 turns retries off
+	Retries *int
+
+	// BaseDelay is the first backoff; each retry doubles it up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// FailureThreshold consecutive failed calls open the breaker for Cooldown
+	FailureThreshold int
+	Cooldown         time.Duration
+}
+
+// DefaultResilience fills in whatever a provider's policy leaves unset
+var DefaultResilience = Resilience{
+	Retries:          RetryCount(2),
+	BaseDelay:        500 * time.Millisecond,
+	MaxDelay:         10 * time.Second,
+	FailureThreshold: 5,
+	Cooldown:         2 * time.Minute,
+}
+
+// RetryCount returns n as a Resilience.Retries value
+func RetryCount(n int) *int {
+	return &n
+}
+
+// withDefaults fills each unset field from DefaultResilience
+func (r Resilience) withDefaults() Resilience {
+	if r.Retries == nil {
+		r.Retries = DefaultResilience.Retries
+	}
+	if r.BaseDelay <= 0 {
+		r.BaseDelay = DefaultResilience.BaseDelay
+	}
+	if r.MaxDelay <= 0 {
+		r.MaxDelay = DefaultResilience.MaxDelay
+	}
+	if r.FailureThreshold <= 0 {
+		r.FailureThreshold = DefaultResilience.FailureThreshold
+	}
+	if r.Cooldown <= 0 {
+		r.Cooldown = DefaultResilience.Cooldown
+	}
+	return r
+}
+
+// retries returns how many times a failed call is repeated, none if unset
+func (r Resilience) retries() int {
+	if r.Retries == nil {
+		return 0
+	}
+	return *r.Retries
+}
+
+// Health describes recent calls to one provider
+type Health struct {
+	Circuit             string    `json:"circuit"`
+	OpenUntil           time.Time `json:"open_until,omitempty"`
+	LastSuccess         time.Time `json:"last_success,omitempty"`
+	LastError           string    `json:"last_error,omitempty"`
+	LastErrorAt         time.Time `json:"last_error_at,omitempty"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	Calls               int64     `json:"calls"`
+	Failures            int64     `json:"failures"`
+	Retries             int64     `json:"retries"`
+	LastLatencyMS       int64     `json:"last_latency_ms"`
+	AvgLatencyMS        int64     `json:"avg_latency_ms"`
+}
+
+// Guard wraps a provider with retries, exponential backoff with jitter and a
+// circuit breaker, and tracks its health
+type Guard struct {
+	provider Provider
+	policy   Resilience
+
+	mu         sync.Mutex
+	health     Health
+	trial      bool // a half-open trial call is in flight
+	latencySum time.Duration
+	succeeded  int64
+	attempts   int64 // Calls to the provider, retries included
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+var _ Provider = (*Guard)(nil)
+
+// NewGuard wraps p with the given policy
+func NewGuard(p Provider, policy Resilience) *Guard {
+	return &Guard{
+		provider: p,
+		policy:   policy,
+		health:   Health{Circuit: CircuitClosed},
+		now:      time.Now,
+		sleep:    sleepContext,
+	}
+}
+
+// Name returns the wrapped provider's name
+func (g *Guard) Name() string { return g.provider.Name() }
+
+// LiveMatches calls the wrapped provider
+func (g *Guard) LiveMatches(ctx context.Context) ([]*domain.Match, error) {
+	return guarded(ctx, g, g.provider.LiveMatches)
+}
+
+// ScheduledMatches calls the wrapped provider
+func (g *Guard) ScheduledMatches(ctx context.Context, day time.Time) ([]*domain.Match, error) {
+	return guarded(ctx, g, func(ctx context.Context) ([]*domain.Match, error) {
+		return g.provider.ScheduledMatches(ctx, day)
+	})
+}
+
+// Rankings calls the wrapped provider
+func (g *Guard) Rankings(ctx context.Context) ([]*domain.Player, error) {
+	return guarded(ctx, g, g.provider.Rankings)
+}
+
+// Tournaments calls the wrapped provider
+func (g *Guard) Tournaments(ctx context.Context) ([]*domain.Tournament, error) {
+	return guarded(ctx, g, g.provider.Tournaments)
+}
+
+// MatchDetail calls the wrapped provider
+func (g *Guard) MatchDetail(ctx context.Context, matchID string) (*domain.Match, error) {
+	return guarded(ctx, g, func(ctx context.Context) (*domain.Match, error) {
+		return g.provider.MatchDetail(ctx, matchID)
+	})
+}
+
+// Health returns a snapshot of the provider's health
+func (g *Guard) Health() Health {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.health.Circuit == CircuitOpen && !g.now().Before(g.health.OpenUntil) {
+		// Report the breaker as ready for a trial call
+		h := g.health
+		h.Circuit = CircuitHalfOpen
+		return h
+	}
+	return g.health
+}
+
+// Attempts returns how many times the provider has been called, retries
+// included
+func (g *Guard) Attempts() int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.attempts
+}
+
+// guarded runs fn with retries unless the breaker is open
+func guarded[T any](ctx context.Context, g *Guard, fn func(context.Context) (T, error)) (T, error) {
+	var zero T
+	if !g.allow() {
+		return zero, fmt.Errorf("%s: %w", g.Name(), ErrCircuitOpen)
+	}
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		g.mu.Lock()
+		g.attempts++
+		g.mu.Unlock()
+
+		start := g.now()
+		var result T
+		result, err = fn(ctx)
+		latency := g.now().Sub(start)
+
+		if err == nil {
+			g.succeed(latency)
+			return result, nil
+		}
+		if errors.Is(err, ErrUnsupported) {
+			g.release()
+			return zero, err
+		}
+		if attempt >= g.policy.retries() || ctx.Err() != nil {
+			break
+		}
+
+		g.mu.Lock()
+		g.health.Retries++
+		g.mu.Unlock()
+		if g.sleep(ctx, g.backoff(attempt)) != nil {
+			break
+		}
+	}
+
+	g.fail(err)
+	return zero, err
+}
+
+// backoff returns the delay before retry n: the doubled base delay, capped,
+// with the upper half jittered
+func (g *Guard) backoff(n int) time.Duration {
+	d := g.policy.BaseDelay << n
+	if d <= 0 || d > g.policy.MaxDelay {
+		d = g.policy.MaxDelay
+	}
+	if d <= 1 {
+		return d
+	}
+	return d/2 + rand.N(d/2)
+}
+
+// allow reports whether a call may go through, moving an expired open
+// breaker to half-open and letting a single trial call through
+func (g *Guard) allow() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	switch g.health.Circuit {
+	case CircuitOpen:
+		if g.now().Before(g.health.OpenUntil) {
+			return false
+		}
+		g.health.Circuit = CircuitHalfOpen
+		g.trial = true
+		return true
+	case CircuitHalfOpen:
+		if g.trial {
+			return false
+		}
+		g.trial = true
+		return true
+	}
+	return true
+}
+
+// release ends a half-open trial that neither succeeded nor failed
+func (g *Guard) release() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.trial = false
+}
+
+func (g *Guard) succeed(latency time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.health.Circuit != CircuitClosed {
+		log.Printf("🔌 %s circuit closed", g.Name())
+	}
+	g.health.Circuit = CircuitClosed
+	g.health.OpenUntil = time.Time{}
+	g.trial = false
+	g.health.ConsecutiveFailures = 0
+	g.health.LastSuccess = g.now()
+	g.health.Calls++
+
+	g.succeeded++
+	g.latencySum += latency
+	g.health.LastLatencyMS = latency.Milliseconds()
+	g.health.AvgLatencyMS = (g.latencySum / time.Duration(g.succeeded)).Milliseconds()
+}
+
+func (g *Guard) fail(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.trial = false
+	g.health.Calls++
+	g.health.Failures++
+	g.health.ConsecutiveFailures++
+	g.health.LastError = err.Error()
+	g.health.LastErrorAt = g.now()
+
+	if g.health.Circuit == CircuitHalfOpen || g.health.ConsecutiveFailures >= g.policy.FailureThreshold {
+		g.health.Circuit = CircuitOpen
+		g.health.OpenUntil = g.now().Add(g.policy.Cooldown)
+		log.Printf("🔌 %s circuit open for %v after %d failures: %v",
+			g.Name(), g.policy.Cooldown, g.health.ConsecutiveFailures, err)
+	}
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
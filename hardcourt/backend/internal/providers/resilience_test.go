@@ -0,0 +1,125 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// flakyProvider fails its first failures calls to LiveMatches
+type flakyProvider struct {
+	fakeProvider
+	failures int
+	calls    int
+}
+
+func (p *flakyProvider) LiveMatches(ctx context.Context) ([]*domain.Match, error) {
+	p.calls++
+	if p.calls <= p.failures {
+		return nil, errors.New("503 from feed")
+	}
+	return p.matches, nil
+}
+
+// newTestGuard returns a guard with a settable clock that never sleeps
+func newTestGuard(p Provider, policy Resilience) (*Guard, *time.Time, *[]time.Duration) {
+	now := time.Date(2025, 1, 26, 8, 30, 0, 0, time.UTC)
+	var delays []time.Duration
+	g := NewGuard(p, policy)
+	g.now = func() time.Time { return now }
+	g.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+	return g, &now, &delays
+}
+
+func TestGuard_RetriesWithBackoff(t *testing.T) {
+	p := &flakyProvider{fakeProvider: fakeProvider{name: "fake", matches: []*domain.Match{{ID: "m1"}}}, failures: 2}
+	g, _, delays := newTestGuard(p, Resilience{Retries: RetryCount(2), BaseDelay: time.Second, MaxDelay: time.Minute, FailureThreshold: 3, Cooldown: time.Minute})
+
+	matches, err := g.LiveMatches(context.Background())
+	if err != nil || len(matches) != 1 {
+		t.Fatalf("Expected the third attempt to succeed, got %v", err)
+	}
+	if len(*delays) != 2 {
+		t.Fatalf("Expected 2 backoffs, got %v", *delays)
+	}
+	for i, d := range *delays {
+		full := time.Second << i
+		if d < full/2 || d > full {
+			t.Errorf("Backoff %d = %v, want between %v and %v", i, d, full/2, full)
+		}
+	}
+
+	health := g.Health()
+	if health.Circuit != CircuitClosed || health.Calls != 1 || health.Retries != 2 || health.LastSuccess.IsZero() {
+		t.Errorf("Unexpected health: %+v", health)
+	}
+}
+
+func TestGuard_CircuitBreaker(t *testing.T) {
+	p := &flakyProvider{fakeProvider: fakeProvider{name: "fake"}, failures: 3}
+	g, now, _ := newTestGuard(p, Resilience{FailureThreshold: 2, Cooldown: time.Minute})
+
+	for i := 0; i < 2; i++ {
+		if _, err := g.LiveMatches(context.Background()); err == nil {
+			t.Fatal("Expected the call to fail")
+		}
+	}
+	if health := g.Health(); health.Circuit != CircuitOpen || health.ConsecutiveFailures != 2 {
+		t.Fatalf("Expected the breaker to open after 2 failures, got %+v", health)
+	}
+
+	// Open: calls are rejected without reaching the provider
+	if _, err := g.LiveMatches(context.Background()); !errors.Is(err, ErrCircuitOpen) || p.calls != 2 {
+		t.Fatalf("Expected ErrCircuitOpen without a call, got %v after %d calls", err, p.calls)
+	}
+	// The breaker covers every method, not just the one that failed
+	if _, err := g.Rankings(context.Background()); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected every method to be guarded, got %v", err)
+	}
+
+	// A failed trial after the cooldown reopens the breaker
+	*now = now.Add(time.Minute)
+	if _, err := g.LiveMatches(context.Background()); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected a trial call that fails, got %v", err)
+	}
+	if health := g.Health(); health.Circuit != CircuitOpen {
+		t.Fatalf("Expected the failed trial to reopen the breaker, got %s", health.Circuit)
+	}
+
+	// A successful trial closes it
+	*now = now.Add(time.Minute)
+	if _, err := g.LiveMatches(context.Background()); err != nil {
+		t.Fatalf("Expected the trial call to succeed, got %v", err)
+	}
+	if health := g.Health(); health.Circuit != CircuitClosed || health.ConsecutiveFailures != 0 || health.Failures != 3 {
+		t.Errorf("Expected a closed breaker after a good trial, got %+v", health)
+	}
+}
+
+func TestLimitHosts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: LimitHosts(time.Hour, 1)(http.DefaultTransport)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected the first request to go through: %v", err)
+	}
+	resp.Body.Close()
+
+	// The host's budget is spent, so the next request waits past its deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if _, err := client.Do(req); err == nil {
+		t.Error("Expected the second request to be held back by the rate limit")
+	}
+}
@@ -0,0 +1,484 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// Scheduler polls every enabled provider on its own intervals and hands the
+// results to a Sink. Live polls are paced by what the provider last reported
+// and never exceed its hourly budget.
+type Scheduler struct {
+	registry *Registry
+	sink     Sink
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	running  bool
+	mu       sync.Mutex
+
+	// Per-provider status, guards, budgets and last reports, guarded by mu
+	status    map[string]*ProviderStatus
+	guards    map[string]*Guard
+	budgets   map[string]*rate.Limiter
+	live      map[string][]*domain.Match
+	schedules map[string]time.Time // next scheduled start per provider that has a schedule
+
+	// Matches that left a provider's live feed without a result yet, with
+	// how many lookups of them failed, guarded by mu
+	resolving map[string]map[string]int
+
+	// Calls each provider has made and how many of them the budget has
+	// been charged for, guarded by mu
+	calls   map[string]func() int64
+	charged map[string]int64
+
+	// Clock for the schedule's days and match times, see SetClock
+	now func() time.Time
+}
+
+// resolveAttempts is how many failed lookups a match that left the live
+// feed gets before it is given up on
+const resolveAttempts = 3
+
+// ProviderStatus describes the last poll of one provider
+type ProviderStatus struct {
+	Interval        string    `json:"interval"`
+	CatalogInterval string    `json:"catalog_interval"`
+	LastLiveRun     time.Time `json:"last_live_run,omitempty"`
+	LastCatalogRun  time.Time `json:"last_catalog_run,omitempty"`
+	LiveMatches     int       `json:"live_matches"`
+	Pace            string    `json:"pace"`
+	NextLivePoll    time.Time `json:"next_live_poll,omitempty"`
+	HourlyBudget    int       `json:"hourly_budget"`
+	RecordsIngested int64     `json:"records_ingested"`
+	RecordsFailed   int64     `json:"records_failed"`
+	LastError       string    `json:"last_error,omitempty"`
+	Health          Health    `json:"health"`
+}
+
+// NewScheduler creates a scheduler for the registry's enabled providers
+func NewScheduler(registry *Registry, sink Sink) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		registry:  registry,
+		sink:      sink,
+		ctx:       ctx,
+		cancel:    cancel,
+		status:    make(map[string]*ProviderStatus),
+		guards:    make(map[string]*Guard),
+		budgets:   make(map[string]*rate.Limiter),
+		live:      make(map[string][]*domain.Match),
+		schedules: make(map[string]time.Time),
+		resolving: make(map[string]map[string]int),
+		calls:     make(map[string]func() int64),
+		charged:   make(map[string]int64),
+		now:       time.Now,
+	}
+}
+
+// SetClock replaces the clock the scheduler asks for each day's schedule
+// and paces live polls around match times by, e.g. to replay a recorded
+// day. Budgets keep the wall clock. Call it before Start.
+func (s *Scheduler) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// Start runs one full poll of every provider, waits for it to finish, then
+// keeps polling in the background
+func (s *Scheduler) Start() error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return nil
+	}
+	s.running = true
+	entries := s.registry.Enabled()
+	for i, entry := range entries {
+		// Every call goes through the provider's retries and circuit breaker
+		guard := NewGuard(entry.Provider, entry.Config.Resilience)
+		entries[i].Provider = guard
+		s.guards[guard.Name()] = guard
+		s.budgets[guard.Name()] = newBudget(entry.Config.HourlyBudget)
+		s.calls[guard.Name()] = guard.Attempts
+		if entry.Config.Calls != nil {
+			s.calls[guard.Name()] = entry.Config.Calls.Count
+		}
+		s.status[entry.Provider.Name()] = &ProviderStatus{
+			Interval:        entry.Config.Interval.String(),
+			CatalogInterval: entry.Config.CatalogInterval.String(),
+			HourlyBudget:    entry.Config.HourlyBudget,
+		}
+	}
+	s.mu.Unlock()
+
+	log.Printf("🕷️  Starting provider scheduler (%d providers)", len(entries))
+
+	// Initial poll, so callers see data as soon as Start returns
+	var initial sync.WaitGroup
+	for _, entry := range entries {
+		initial.Add(1)
+		go func(entry Entry) {
+			defer initial.Done()
+			s.pollCatalog(entry)
+			s.pollLive(entry)
+			s.charge(entry.Provider.Name(), 0)
+		}(entry)
+	}
+	initial.Wait()
+
+	for _, entry := range entries {
+		s.wg.Add(1)
+		go s.run(entry)
+	}
+	return nil
+}
+
+// Stop cancels polling and waits for in-flight polls to finish
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.mu.Unlock()
+
+	log.Println("🛑 Stopping provider scheduler...")
+	s.cancel()
+	s.wg.Wait()
+
+	s.mu.Lock()
+	s.running = false
+	s.mu.Unlock()
+	log.Println("✅ Provider scheduler stopped")
+}
+
+// run polls one provider until the scheduler stops
+func (s *Scheduler) run(entry Entry) {
+	defer s.wg.Done()
+
+	live := time.NewTimer(s.nextLive(entry))
+	defer live.Stop()
+	catalog := time.NewTicker(entry.Config.CatalogInterval)
+	defer catalog.Stop()
+
+	for {
+		select {
+		case <-live.C:
+			s.pollLive(entry)
+			s.charge(entry.Provider.Name(), 1)
+			live.Reset(s.nextLive(entry))
+		case <-catalog.C:
+			// A catalog poll makes at least four calls; wait if the budget is spent
+			if !s.spend(entry.Provider.Name(), catalogCalls) {
+				return
+			}
+			s.pollCatalog(entry)
+			s.charge(entry.Provider.Name(), catalogCalls)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// catalogCalls is how many provider calls one catalog poll makes at least
+const catalogCalls = 4
+
+// newBudget allows perHour calls an hour, with short bursts for critical moments
+func newBudget(perHour int) *rate.Limiter {
+	burst := perHour / 30
+	if burst < catalogCalls {
+		burst = catalogCalls
+	}
+	return rate.NewLimiter(rate.Every(time.Hour/time.Duration(perHour)), burst)
+}
+
+// nextLive paces the provider's next live poll and reserves it from the budget
+func (s *Scheduler) nextLive(entry Entry) time.Duration {
+	name := entry.Provider.Name()
+	now, clock := time.Now(), s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	activity := s.activity(name)
+	wait := activity.LiveInterval(entry.Config.Interval, clock)
+	if budget, ok := s.budgets[name]; ok {
+		wait = maxDuration(wait, budget.ReserveN(now.Add(wait), 1).DelayFrom(now))
+	}
+	if st, ok := s.status[name]; ok {
+		st.Pace = activity.Mode(clock)
+		st.NextLivePoll = now.Add(wait)
+	}
+	return wait
+}
+
+// charge takes the calls the provider made since it was last charged from
+// its budget, less the prepaid ones already reserved for the poll. Retries,
+// statistics requests and match lookups all count, so they hold back the
+// next polls instead of overrunning the budget.
+func (s *Scheduler) charge(name string, prepaid int) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	calls, ok := s.calls[name]
+	budget, budgeted := s.budgets[name]
+	if !ok || !budgeted {
+		return
+	}
+	total := calls()
+	extra := int(total-s.charged[name]) - prepaid
+	s.charged[name] = total
+
+	// A reservation cannot exceed the burst, so large polls are charged in parts
+	for extra > 0 {
+		n := minInt(extra, budget.Burst())
+		budget.ReserveN(now, n)
+		extra -= n
+	}
+}
+
+// spend takes n calls from the provider's budget, waiting until they are
+// available. It returns false if the scheduler stopped first.
+func (s *Scheduler) spend(name string, n int) bool {
+	s.mu.Lock()
+	budget, ok := s.budgets[name]
+	s.mu.Unlock()
+	if !ok {
+		return true
+	}
+	return budget.WaitN(s.ctx, n) == nil
+}
+
+// activity combines the provider's live matches with the schedule from every
+// provider that reports one. Callers hold mu.
+func (s *Scheduler) activity(name string) Activity {
+	activity := Activity{Live: s.live[name]}
+	for _, next := range s.schedules {
+		activity.ScheduleKnown = true
+		if !next.IsZero() && (activity.NextStart.IsZero() || next.Before(activity.NextStart)) {
+			activity.NextStart = next
+		}
+	}
+	return activity
+}
+
+// pollLive fetches live matches, bounded by the provider's interval
+func (s *Scheduler) pollLive(entry Entry) {
+	name := entry.Provider.Name()
+	ctx, cancel := context.WithTimeout(s.ctx, pollTimeout(entry.Config.Interval))
+	defer cancel()
+
+	matches, err := entry.Provider.LiveMatches(ctx)
+	fetched := err == nil
+	var ingested Ingested
+	if fetched {
+		// Feeds drop matches once they finish, so the result of each match
+		// that left is looked up and stored with the live ones
+		ingested, err = s.sink.IngestMatches(ctx, name, append(s.resolve(ctx, entry, matches), matches...))
+	}
+	s.record(name, err, func(st *ProviderStatus) {
+		st.LastLiveRun = time.Now()
+		st.LiveMatches = len(matches)
+		st.RecordsIngested += int64(ingested.Stored)
+		st.RecordsFailed += int64(ingested.Failed)
+		if fetched {
+			s.live[name] = matches
+		}
+	})
+}
+
+// resolve looks up the matches that were live at the provider's last poll
+// and are not live now. Failed lookups are retried on the next polls.
+func (s *Scheduler) resolve(ctx context.Context, entry Entry, live []*domain.Match) []*domain.Match {
+	name := entry.Provider.Name()
+	current := make(map[string]bool, len(live))
+	for _, m := range live {
+		current[m.ID] = true
+	}
+
+	s.mu.Lock()
+	pending := s.resolving[name]
+	if pending == nil {
+		pending = make(map[string]int)
+		s.resolving[name] = pending
+	}
+	for _, m := range s.live[name] {
+		if _, ok := pending[m.ID]; !ok {
+			pending[m.ID] = 0
+		}
+	}
+	lookups := make(map[string]int, len(pending))
+	for id, failed := range pending {
+		if current[id] {
+			delete(pending, id)
+			continue
+		}
+		lookups[id] = failed
+	}
+	s.mu.Unlock()
+
+	var resolved []*domain.Match
+	for id, failed := range lookups {
+		match, err := entry.Provider.MatchDetail(ctx, id)
+		switch {
+		case err == nil:
+			resolved = append(resolved, match)
+			failed = resolveAttempts
+		case errors.Is(err, ErrUnsupported):
+			failed = resolveAttempts
+		default:
+			failed++
+			if failed == resolveAttempts {
+				log.Printf("⚠️  %s: gave up on the result of %s: %v", name, id, err)
+			}
+		}
+
+		s.mu.Lock()
+		if failed >= resolveAttempts {
+			delete(pending, id)
+		} else {
+			pending[id] = failed
+		}
+		s.mu.Unlock()
+	}
+	return resolved
+}
+
+// pollCatalog fetches rankings, tournaments and the schedule for today and
+// tomorrow
+func (s *Scheduler) pollCatalog(entry Entry) {
+	name := entry.Provider.Name()
+	ctx, cancel := context.WithTimeout(s.ctx, pollTimeout(entry.Config.CatalogInterval))
+	defer cancel()
+
+	var errs []error
+	var ingested, failed int64
+	collect := func(n Ingested, err error) {
+		ingested += int64(n.Stored)
+		failed += int64(n.Failed)
+		if err != nil && !errors.Is(err, ErrUnsupported) {
+			errs = append(errs, err)
+		}
+	}
+
+	if players, err := entry.Provider.Rankings(ctx); err == nil {
+		collect(s.sink.IngestPlayers(ctx, name, players))
+	} else {
+		collect(Ingested{}, err)
+	}
+	if tournaments, err := entry.Provider.Tournaments(ctx); err == nil {
+		collect(s.sink.IngestTournaments(ctx, name, tournaments))
+	} else {
+		collect(Ingested{}, err)
+	}
+
+	// Today's and tomorrow's schedule, so the order of play is known a day ahead
+	var scheduled []*domain.Match
+	supported := true
+	today := s.now()
+	for _, day := range []time.Time{today, today.AddDate(0, 0, 1)} {
+		matches, err := entry.Provider.ScheduledMatches(ctx, day)
+		if errors.Is(err, ErrUnsupported) {
+			supported = false
+			break
+		}
+		var n Ingested
+		if err == nil {
+			n, err = s.sink.IngestMatches(ctx, name, matches)
+			scheduled = append(scheduled, matches...)
+		}
+		collect(n, err)
+	}
+	if supported {
+		s.mu.Lock()
+		s.schedules[name] = NextStart(scheduled, s.now())
+		s.mu.Unlock()
+	}
+
+	s.record(name, errors.Join(errs...), func(st *ProviderStatus) {
+		st.LastCatalogRun = time.Now()
+		st.RecordsIngested += ingested
+		st.RecordsFailed += failed
+	})
+}
+
+// record updates a provider's status. ErrUnsupported is not an error, and an
+// open breaker was already logged when it opened.
+func (s *Scheduler) record(name string, err error, update func(*ProviderStatus)) {
+	if errors.Is(err, ErrUnsupported) {
+		err = nil
+	}
+	if err != nil && !errors.Is(err, ErrCircuitOpen) {
+		log.Printf("⚠️  %s provider error: %v", name, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.status[name]
+	if !ok {
+		return
+	}
+	update(st)
+	st.LastError = ""
+	if err != nil {
+		st.LastError = err.Error()
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// pollTimeout leaves headroom before the next tick
+func pollTimeout(interval time.Duration) time.Duration {
+	timeout := interval - interval/12
+	if timeout > time.Minute {
+		timeout = time.Minute
+	}
+	return timeout
+}
+
+// GetStatus returns the current scheduler status
+func (s *Scheduler) GetStatus() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	providers := make(map[string]ProviderStatus, len(s.status))
+	healthy := true
+	for name, st := range s.status {
+		status := *st
+		if guard, ok := s.guards[name]; ok {
+			status.Health = guard.Health()
+			healthy = healthy && status.Health.Circuit == CircuitClosed
+		}
+		providers[name] = status
+	}
+	return map[string]interface{}{
+		"running":   s.running,
+		"healthy":   healthy,
+		"providers": providers,
+	}
+}
+
+// Health returns each provider's call health
+func (s *Scheduler) Health() map[string]Health {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health := make(map[string]Health, len(s.guards))
+	for name, guard := range s.guards {
+		health[name] = guard.Health()
+	}
+	return health
+}
@@ -0,0 +1,272 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+type fakeProvider struct {
+	name    string
+	matches []*domain.Match
+}
+
+func (p *fakeProvider) Name() string { return p.name }
+
+func (p *fakeProvider) LiveMatches(ctx context.Context) ([]*domain.Match, error) {
+	return p.matches, nil
+}
+
+func (p *fakeProvider) ScheduledMatches(ctx context.Context, day time.Time) ([]*domain.Match, error) {
+	return nil, ErrUnsupported
+}
+
+func (p *fakeProvider) Rankings(ctx context.Context) ([]*domain.Player, error) {
+	return []*domain.Player{{ID: "j-sinner", Rank: 1}}, nil
+}
+
+func (p *fakeProvider) Tournaments(ctx context.Context) ([]*domain.Tournament, error) {
+	return nil, ErrUnsupported
+}
+
+func (p *fakeProvider) MatchDetail(ctx context.Context, matchID string) (*domain.Match, error) {
+	return nil, ErrUnsupported
+}
+
+// finishingProvider is a live feed that drops matches once they finish,
+// with their results available as match details
+type finishingProvider struct {
+	fakeProvider
+	results map[string]*domain.Match
+	lookups int
+}
+
+func (p *finishingProvider) MatchDetail(ctx context.Context, matchID string) (*domain.Match, error) {
+	p.lookups++
+	if m, ok := p.results[matchID]; ok {
+		return m, nil
+	}
+	return nil, errors.New("not found")
+}
+
+// schedulingProvider records the days its schedule is asked for
+type schedulingProvider struct {
+	fakeProvider
+	days []string
+}
+
+func (p *schedulingProvider) ScheduledMatches(ctx context.Context, day time.Time) ([]*domain.Match, error) {
+	p.days = append(p.days, day.Format("2006-01-02"))
+	return nil, nil
+}
+
+type recordingSink struct {
+	mu       sync.Mutex
+	matches  map[string]int
+	players  int
+	finished []string
+	reject   map[string]bool // match IDs that fail to store
+}
+
+func (s *recordingSink) IngestMatches(ctx context.Context, source string, matches []*domain.Match) (Ingested, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ingested Ingested
+	for _, m := range matches {
+		if s.reject[m.ID] {
+			ingested.Failed++
+			continue
+		}
+		ingested.Stored++
+		if m.Status == domain.StatusFinished {
+			s.finished = append(s.finished, m.ID)
+		}
+	}
+	s.matches[source] += ingested.Stored
+	if ingested.Failed > 0 {
+		return ingested, fmt.Errorf("failed to store %d of %d matches", ingested.Failed, len(matches))
+	}
+	return ingested, nil
+}
+
+func (s *recordingSink) IngestPlayers(ctx context.Context, source string, players []*domain.Player) (Ingested, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.players += len(players)
+	return Ingested{Stored: len(players)}, nil
+}
+
+func (s *recordingSink) IngestTournaments(ctx context.Context, source string, tournaments []*domain.Tournament) (Ingested, error) {
+	return Ingested{Stored: len(tournaments)}, nil
+}
+
+func TestRegistry_Configure(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&fakeProvider{name: "sofascore"}, Config{Interval: 30 * time.Second})
+	registry.Register(&fakeProvider{name: "atp"}, Config{})
+	registry.Register(&fakeProvider{name: "flashscore"}, Config{})
+
+	env := map[string]string{
+		"PROVIDERS":                     "sofascore, ATP",
+		"PROVIDER_ATP_INTERVAL":         "5m",
+		"PROVIDER_SOFASCORE_INTERVAL":   "not-a-duration",
+		"PROVIDER_ATP_CATALOG_INTERVAL": "12h",
+	}
+	registry.Configure(func(key string) string { return env[key] })
+
+	enabled := registry.Enabled()
+	if len(enabled) != 2 {
+		t.Fatalf("Expected 2 enabled providers, got %d", len(enabled))
+	}
+	if enabled[0].Provider.Name() != "sofascore" || enabled[0].Config.Interval != 30*time.Second {
+		t.Errorf("Expected sofascore to keep its 30s interval, got %+v", enabled[0].Config)
+	}
+	if enabled[1].Config.Interval != 5*time.Minute || enabled[1].Config.CatalogInterval != 12*time.Hour {
+		t.Errorf("Expected atp overrides to apply, got %+v", enabled[1].Config)
+	}
+
+	if _, ok := registry.Get("flashscore"); !ok {
+		t.Error("Expected disabled provider to stay registered")
+	}
+}
+
+func TestRegistry_ResilienceDefaults(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&fakeProvider{name: "atp"}, Config{Resilience: Resilience{Cooldown: 10 * time.Minute}})
+
+	// Only the cooldown was set; the breaker must not open on the first failure
+	got := registry.Enabled()[0].Config.Resilience
+	want := DefaultResilience
+	want.Cooldown = 10 * time.Minute
+	if got != want {
+		t.Errorf("Resilience = %+v, want %+v", got, want)
+	}
+}
+
+func TestRegistry_KeepsExplicitZeroRetries(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&fakeProvider{name: "atp"}, Config{Resilience: Resilience{Retries: RetryCount(0)}})
+
+	if got := registry.Enabled()[0].Config.Resilience; got.Retries == nil || *got.Retries != 0 {
+		t.Errorf("Expected retries to stay off, got %+v", got)
+	}
+}
+
+func TestScheduler_PollsProviders(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&fakeProvider{
+		name:    "fake",
+		matches: []*domain.Match{{ID: "m1", Status: domain.StatusLive}},
+	}, Config{Interval: 10 * time.Millisecond})
+
+	sink := &recordingSink{matches: make(map[string]int)}
+	scheduler := NewScheduler(registry, sink)
+	if err := scheduler.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer scheduler.Stop()
+
+	// Start returns after the first poll
+	sink.mu.Lock()
+	if sink.matches["fake"] != 1 || sink.players != 1 {
+		t.Errorf("Expected initial poll of matches and rankings, got %v matches, %d players", sink.matches, sink.players)
+	}
+	sink.mu.Unlock()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		sink.mu.Lock()
+		polled := sink.matches["fake"]
+		sink.mu.Unlock()
+		if polled >= 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected periodic polls, got %d", polled)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	status := scheduler.GetStatus()["providers"].(map[string]ProviderStatus)["fake"]
+	if status.LiveMatches != 1 || status.LastError != "" || status.LastLiveRun.IsZero() {
+		t.Errorf("Unexpected provider status: %+v", status)
+	}
+	// One player from rankings plus every live match polled
+	if status.RecordsIngested < 4 || status.Health.Circuit != CircuitClosed || status.Health.LastSuccess.IsZero() {
+		t.Errorf("Expected ingest counts and health in the status, got %+v", status)
+	}
+}
+
+func TestScheduler_ResolvesFinishedMatches(t *testing.T) {
+	provider := &finishingProvider{
+		fakeProvider: fakeProvider{name: "fake", matches: []*domain.Match{
+			{ID: "m1", Status: domain.StatusLive},
+			{ID: "m2", Status: domain.StatusLive},
+		}},
+		results: map[string]*domain.Match{"m1": {ID: "m1", Status: domain.StatusFinished}},
+	}
+	sink := &recordingSink{matches: make(map[string]int)}
+	scheduler := NewScheduler(NewRegistry(), sink)
+	scheduler.status["fake"] = &ProviderStatus{}
+	entry := Entry{Provider: provider, Config: Config{Interval: time.Second}}
+
+	scheduler.pollLive(entry)
+	if provider.lookups != 0 {
+		t.Fatalf("Expected no lookups while both matches are live, got %d", provider.lookups)
+	}
+
+	// Both leave the feed; m1's result is found, m2's lookup keeps failing
+	provider.matches = nil
+	scheduler.pollLive(entry)
+	if len(sink.finished) != 1 || sink.finished[0] != "m1" {
+		t.Fatalf("Expected m1's result stored, got %v", sink.finished)
+	}
+
+	for i := 0; i < resolveAttempts+1; i++ {
+		scheduler.pollLive(entry)
+	}
+	if provider.lookups != 1+resolveAttempts {
+		t.Errorf("Expected m1 looked up once and m2 %d times, got %d lookups", resolveAttempts, provider.lookups)
+	}
+}
+
+func TestScheduler_SchedulesOnItsClock(t *testing.T) {
+	provider := &schedulingProvider{fakeProvider: fakeProvider{name: "fake"}}
+	scheduler := NewScheduler(NewRegistry(), &recordingSink{matches: make(map[string]int)})
+	scheduler.status["fake"] = &ProviderStatus{}
+
+	// A replayed day asks for the recorded day's schedule, not today's
+	recorded := time.Date(2025, 6, 7, 13, 0, 0, 0, time.UTC)
+	scheduler.SetClock(func() time.Time { return recorded })
+	scheduler.pollCatalog(Entry{Provider: provider, Config: Config{CatalogInterval: time.Hour}})
+
+	if len(provider.days) != 2 || provider.days[0] != "2025-06-07" || provider.days[1] != "2025-06-08" {
+		t.Errorf("Expected the schedules for 7 and 8 June 2025, got %v", provider.days)
+	}
+}
+
+func TestScheduler_CountsFailedRecords(t *testing.T) {
+	provider := &fakeProvider{name: "fake", matches: []*domain.Match{
+		{ID: "m1", Status: domain.StatusLive},
+		{ID: "m2", Status: domain.StatusLive},
+	}}
+	sink := &recordingSink{matches: make(map[string]int), reject: map[string]bool{"m2": true}}
+	scheduler := NewScheduler(NewRegistry(), sink)
+	scheduler.status["fake"] = &ProviderStatus{}
+
+	scheduler.pollLive(Entry{Provider: provider, Config: Config{Interval: time.Second}})
+
+	st := scheduler.status["fake"]
+	if st.RecordsIngested != 1 || st.RecordsFailed != 1 || st.LastError == "" {
+		t.Errorf("Expected 1 match stored and 1 failed with an error, got %+v", st)
+	}
+	// The feed itself was read, so both matches count as live
+	if len(scheduler.live["fake"]) != 2 {
+		t.Errorf("Expected the live set to follow the feed, got %d matches", len(scheduler.live["fake"]))
+	}
+}
@@ -0,0 +1,124 @@
+package providers
+
+import (
+	"fmt"
+	"strings"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// tournamentAliases maps the names feeds use for a tournament to the ID
+// prefix of the seeded tournament, so live data links to e.g. aus-open-2025
+var tournamentAliases = map[string]string{
+	"australian-open": "aus-open",
+
+	"roland-garros": "roland-garros",
+	"french-open":   "roland-garros",
+
+	"wimbledon": "wimbledon",
+
+	"us-open": "us-open",
+
+	"indian-wells":     "indian-wells",
+	"bnp-paribas-open": "indian-wells",
+
+	"miami":      "miami",
+	"miami-open": "miami",
+
+	"monte-carlo":         "monte-carlo",
+	"monte-carlo-masters": "monte-carlo",
+
+	"madrid":            "madrid",
+	"madrid-open":       "madrid",
+	"mutua-madrid-open": "madrid",
+
+	"rome":                       "rome",
+	"italian-open":               "rome",
+	"internazionali-bnl-ditalia": "rome",
+
+	"canada":             "canada",
+	"canadian-open":      "canada",
+	"national-bank-open": "canada",
+	"montreal":           "canada",
+	"toronto":            "canada",
+
+	"cincinnati":         "cincinnati",
+	"cincinnati-masters": "cincinnati",
+	"cincinnati-open":    "cincinnati",
+
+	"shanghai":         "shanghai",
+	"shanghai-masters": "shanghai",
+
+	"paris":         "paris",
+	"paris-masters": "paris",
+}
+
+// TournamentID returns the canonical ID for one edition of a tournament.
+// Known tournaments resolve to their seeded IDs; anything else gets an ID
+// derived from its name.
+func TournamentID(name string, year int) string {
+	if alias, ok := tournamentAliases[tournamentSlug(name)]; ok {
+		return fmt.Sprintf("%s-%d", alias, year)
+	}
+	return domain.TournamentIDFromName(name, year)
+}
+
+// tournamentSlug normalises a display name for alias lookup, dropping
+// sponsor prefixes: "Rolex Monte-Carlo Masters" -> "monte-carlo-masters"
+func tournamentSlug(name string) string {
+	slug := strings.ToLower(strings.TrimSpace(name))
+	slug = strings.TrimPrefix(slug, "rolex ")
+	slug = strings.NewReplacer("'", "", ".", "", ",", "").Replace(slug)
+	return strings.Join(strings.Fields(slug), "-")
+}
+
+// Surface normalises a feed's surface description to Hard, Clay or Grass.
+// Unknown surfaces return "" so they never overwrite known data.
+func Surface(groundType string) string {
+	g := strings.ToLower(groundType)
+	switch {
+	case strings.Contains(g, "clay"):
+		return "Clay"
+	case strings.Contains(g, "grass"):
+		return "Grass"
+	case strings.Contains(g, "hard"), strings.Contains(g, "synthetic"), strings.Contains(g, "carpet"):
+		return "Hard"
+	}
+	return ""
+}
+
+// Category derives a tournament category from its ranking points and tour
+func Category(points int, tour string) string {
+	tour = strings.ToUpper(strings.TrimSpace(tour))
+	switch {
+	case points >= 2000:
+		return "Grand Slam"
+	case points >= 1000 && tour == "WTA":
+		return "WTA 1000"
+	case points >= 1000:
+		return "Masters 1000"
+	case (points == 500 || points == 250) && (tour == "ATP" || tour == "WTA"):
+		return fmt.Sprintf("%s %d", tour, points)
+	}
+	return tour
+}
+
+// Round normalises round names to the codes used in draws: R128 ... QF, SF, F.
+// Names that do not match are returned unchanged.
+func Round(name string) string {
+	n := strings.ToLower(strings.TrimSpace(name))
+	switch n {
+	case "final":
+		return "F"
+	case "semifinal", "semifinals", "semi-final", "semi-finals":
+		return "SF"
+	case "quarterfinal", "quarterfinals", "quarter-final", "quarter-finals":
+		return "QF"
+	}
+	for _, size := range []string{"16", "32", "64", "128"} {
+		if n == "round of "+size {
+			return "R" + size
+		}
+	}
+	return name
+}
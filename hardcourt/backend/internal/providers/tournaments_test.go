@@ -0,0 +1,56 @@
+package providers
+
+import "testing"
+
+func TestTournamentID(t *testing.T) {
+	tests := []struct {
+		name string
+		year int
+		want string
+	}{
+		{"Australian Open", 2025, "aus-open-2025"},
+		{"Roland Garros", 2024, "roland-garros-2024"},
+		{"Rolex Monte-Carlo Masters", 2025, "monte-carlo-2025"},
+		{"National Bank Open", 2024, "canada-2024"},
+		{"Internazionali BNL d'Italia", 2025, "rome-2025"},
+	}
+	for _, tt := range tests {
+		if got := TournamentID(tt.name, tt.year); got != tt.want {
+			t.Errorf("TournamentID(%q, %d) = %q, want %q", tt.name, tt.year, got, tt.want)
+		}
+	}
+
+	// Unknown tournaments fall back to a name-derived ID
+	if got := TournamentID("Open Sud de France", 2025); got == "" || got == "aus-open-2025" {
+		t.Errorf("Expected a derived ID, got %q", got)
+	}
+}
+
+func TestSurfaceCategoryRound(t *testing.T) {
+	if got := Surface("Red clay"); got != "Clay" {
+		t.Errorf("Expected Clay, got %q", got)
+	}
+	if got := Surface("Hardcourt outdoor"); got != "Hard" {
+		t.Errorf("Expected Hard, got %q", got)
+	}
+	if got := Surface(""); got != "" {
+		t.Errorf("Expected unknown surface to be empty, got %q", got)
+	}
+
+	if got := Category(2000, "ATP"); got != "Grand Slam" {
+		t.Errorf("Expected Grand Slam, got %q", got)
+	}
+	if got := Category(1000, "WTA"); got != "WTA 1000" {
+		t.Errorf("Expected WTA 1000, got %q", got)
+	}
+	if got := Category(500, "atp"); got != "ATP 500" {
+		t.Errorf("Expected ATP 500, got %q", got)
+	}
+
+	rounds := map[string]string{"Final": "F", "Semifinals": "SF", "Quarterfinals": "QF", "Round of 16": "R16", "Qualification": "Qualification"}
+	for in, want := range rounds {
+		if got := Round(in); got != want {
+			t.Errorf("Round(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package reconcile
+
+import (
+	"strings"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// Key identifies a match across sources by its tournament, the day it
+// starts and its players' surnames, since every source has its own match and
+// player IDs. Matches without both player names are only matched by ID.
+func Key(match *domain.Match) string {
+	return identify(match).key()
+}
+
+// identity is what Key is made of. A source that does not report the
+// tournament or start time leaves that part empty and is matched on the rest.
+type identity struct {
+	tournament string
+	day        string
+	pair       [2]string // sorted surnames, or the match ID alone
+}
+
+func identify(match *domain.Match) identity {
+	names := surnames(match)
+	if names[0] == "" || names[1] == "" {
+		return identity{pair: [2]string{"id:" + match.ID}}
+	}
+	if names[1] < names[0] {
+		names[0], names[1] = names[1], names[0]
+	}
+	id := identity{tournament: match.TournamentID, pair: names}
+	if !match.StartTime.IsZero() {
+		id.day = match.StartTime.UTC().Format("2006-01-02")
+	}
+	return id
+}
+
+func (id identity) key() string {
+	if id.pair[1] == "" {
+		return id.pair[0]
+	}
+	return strings.Join([]string{id.tournament, id.day, id.pair[0], id.pair[1]}, "|")
+}
+
+// matches reports whether two identities can be the same match: the same
+// players, and the same tournament and day wherever both sources know them
+func (id identity) matches(other identity) bool {
+	return id.pair == other.pair && id.pair[1] != "" &&
+		(id.tournament == "" || other.tournament == "" || id.tournament == other.tournament) &&
+		(id.day == "" || other.day == "" || id.day == other.day)
+}
+
+func surnames(match *domain.Match) [2]string {
+	var names [2]string
+	if match.Player1 != nil {
+		names[0] = Surname(match.Player1.Name)
+	}
+	if match.Player2 != nil {
+		names[1] = Surname(match.Player2.Name)
+	}
+	return names
+}
+
+// Surname extracts a comparable surname from the name formats feeds use:
+// "Jannik Sinner", "J. Sinner" and "Sinner J." all give "sinner"
+func Surname(name string) string {
+	fields := strings.Fields(strings.ToLower(name))
+	// Drop trailing initials
+	for len(fields) > 1 && strings.HasSuffix(fields[len(fields)-1], ".") {
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.Trim(fields[len(fields)-1], ".")
+}
+
+// swapped returns a copy of the match with players 1 and 2 exchanged
+func swapped(m *domain.Match) *domain.Match {
+	s := *m
+	s.Player1ID, s.Player2ID = m.Player2ID, m.Player1ID
+	s.Player1, s.Player2 = m.Player2, m.Player1
+
+	s.Score = domain.ScoreState{
+		SetsP1: m.Score.SetsP2, SetsP2: m.Score.SetsP1,
+		GamesP1: m.Score.GamesP2, GamesP2: m.Score.GamesP1,
+		PointsP1: m.Score.PointsP2, PointsP2: m.Score.PointsP1,
+	}
+	switch m.Score.Serving {
+	case 1:
+		s.Score.Serving = 2
+	case 2:
+		s.Score.Serving = 1
+	}
+
+	s.Sets = make([]domain.SetScore, len(m.Sets))
+	for i, set := range m.Sets {
+		s.Sets[i] = domain.SetScore{
+			SetNumber: set.SetNumber,
+			GamesP1:   set.GamesP2, GamesP2: set.GamesP1,
+			TiebreakP1: set.TiebreakP2, TiebreakP2: set.TiebreakP1,
+		}
+	}
+
+	st := m.Stats
+	s.Stats = domain.MatchStats{
+		AcesP1: st.AcesP2, AcesP2: st.AcesP1,
+		DoubleFaultsP1: st.DoubleFaultsP2, DoubleFaultsP2: st.DoubleFaultsP1,
+		BreakPointsP1: st.BreakPointsP2, BreakPointsP2: st.BreakPointsP1,
+		WinnersP1: st.WinnersP2, WinnersP2: st.WinnersP1,
+		UnforcedErrorsP1: st.UnforcedErrorsP2, UnforcedErrorsP2: st.UnforcedErrorsP1,
+		FirstServePctP1: st.FirstServePctP2, FirstServePctP2: st.FirstServePctP1,
+		RallyCount: st.RallyCount,
+	}
+
+	if m.WinProbP1 != 0 {
+		s.WinProbP1 = 1 - m.WinProbP1
+	}
+	s.FatigueP1, s.FatigueP2 = m.FatigueP2, m.FatigueP1
+	return &s
+}
@@ -0,0 +1,302 @@
+// Package reconcile merges what several providers report about the same
+// match into one record and remembers which source each field came from.
+package reconcile
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/scoring"
+)
+
+// DefaultPriority ranks sources from most to least trusted
+var DefaultPriority = []string{"sofascore", "flashscore", "atp"}
+
+const (
+	// DefaultMaxAge is how far an observation may lag the newest one for
+	// the same match before it is ignored
+	DefaultMaxAge = 2 * time.Minute
+
+	// Retention is how long a match is remembered after its last observation
+	Retention = 6 * time.Hour
+)
+
+// Fields tracked in provenance
+const (
+	FieldPlayers    = "players"
+	FieldTournament = "tournament"
+	FieldRound      = "round"
+	FieldCourt      = "court"
+	FieldNotBefore  = "not_before"
+	FieldStartTime  = "start_time"
+	FieldStatus     = "status"
+	FieldScore      = "score"
+	FieldWinner     = "winner"
+	FieldStats      = "stats"
+)
+
+// Observation is one source's latest report of a match, oriented so that
+// player 1 is the same player as in the reconciled match
+type Observation struct {
+	Source     string        `json:"source"`
+	ObservedAt time.Time     `json:"observed_at"`
+	Match      *domain.Match `json:"match"`
+}
+
+// Result is a reconciled match and where each of its fields came from
+type Result struct {
+	Match      *domain.Match
+	Provenance []domain.FieldProvenance
+}
+
+// Reconciler keeps the latest observation of each match per source and
+// merges them by source priority, freshness and consistency
+type Reconciler struct {
+	priority map[string]int
+	maxAge   time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*entry // by match key, including keys matched by identity
+	keys    map[string]string // reconciled match ID -> match key
+}
+
+// entry is everything known about one match
+type entry struct {
+	id           string    // reconciled match ID, the first ID any source used
+	identity     identity  // from the first observation
+	names        [2]string // player surnames in reconciled order
+	observations map[string]Observation
+	provenance   []domain.FieldProvenance
+}
+
+// NewReconciler creates a reconciler trusting sources in the given order.
+// Sources not listed rank below all listed ones.
+func NewReconciler(priority []string, maxAge time.Duration) *Reconciler {
+	ranks := make(map[string]int, len(priority))
+	for i, source := range priority {
+		ranks[strings.ToLower(strings.TrimSpace(source))] = i
+	}
+	return &Reconciler{
+		priority: ranks,
+		maxAge:   maxAge,
+		entries:  make(map[string]*entry),
+		keys:     make(map[string]string),
+	}
+}
+
+// Observe records a source's report of a match and returns the reconciled
+// match. A report without the tournament or start time joins a known match
+// between the same players that agrees on the rest.
+func (r *Reconciler) Observe(source string, match *domain.Match, observedAt time.Time) Result {
+	id := identify(match)
+	key := id.key()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.entries[key]
+	if !ok {
+		e = r.find(id)
+		if e == nil {
+			e = &entry{id: match.ID, identity: id, names: surnames(match), observations: make(map[string]Observation)}
+			r.keys[e.id] = key
+		}
+		r.entries[key] = e
+	}
+
+	observed := match
+	if n := surnames(match); n[0] != n[1] && n[0] == e.names[1] && n[1] == e.names[0] {
+		observed = swapped(match)
+	}
+	e.observations[source] = Observation{Source: source, ObservedAt: observedAt, Match: observed}
+
+	merged, provenance := r.merge(e)
+	e.provenance = provenance
+	return Result{Match: merged, Provenance: provenance}
+}
+
+// find returns a known match the identity can belong to, or nil
+func (r *Reconciler) find(id identity) *entry {
+	for _, e := range r.entries {
+		if e.identity.matches(id) {
+			return e
+		}
+	}
+	return nil
+}
+
+// Observations returns every source's latest report of a reconciled match
+// and the current provenance of its fields
+func (r *Reconciler) Observations(matchID string) ([]Observation, []domain.FieldProvenance) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.entries[r.keys[matchID]]
+	if !ok {
+		return nil, nil
+	}
+	return r.ranked(e), e.provenance
+}
+
+// Prune forgets matches no source has reported since before
+func (r *Reconciler) Prune(before time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, e := range r.entries {
+		newest := time.Time{}
+		for _, obs := range e.observations {
+			if obs.ObservedAt.After(newest) {
+				newest = obs.ObservedAt
+			}
+		}
+		if newest.Before(before) {
+			delete(r.entries, key)
+			delete(r.keys, e.id)
+		}
+	}
+}
+
+// ranked orders observations by source priority, newest first within a source rank
+func (r *Reconciler) ranked(e *entry) []Observation {
+	observations := make([]Observation, 0, len(e.observations))
+	for _, obs := range e.observations {
+		observations = append(observations, obs)
+	}
+	sort.Slice(observations, func(i, j int) bool {
+		pi, pj := r.rank(observations[i].Source), r.rank(observations[j].Source)
+		if pi != pj {
+			return pi < pj
+		}
+		return observations[i].ObservedAt.After(observations[j].ObservedAt)
+	})
+	return observations
+}
+
+func (r *Reconciler) rank(source string) int {
+	if rank, ok := r.priority[strings.ToLower(source)]; ok {
+		return rank
+	}
+	return len(r.priority)
+}
+
+// merge builds the reconciled match from the fresh observations. Most fields
+// come from the most trusted source that has them; status and score take the
+// most advanced valid value, since a source that is behind is never ahead.
+func (r *Reconciler) merge(e *entry) (*domain.Match, []domain.FieldProvenance) {
+	all := r.ranked(e)
+	newest := all[0].ObservedAt
+	for _, obs := range all {
+		if obs.ObservedAt.After(newest) {
+			newest = obs.ObservedAt
+		}
+	}
+	var fresh []Observation
+	for _, obs := range all {
+		if newest.Sub(obs.ObservedAt) <= r.maxAge {
+			fresh = append(fresh, obs)
+		}
+	}
+
+	merged := *fresh[0].Match
+	merged.ID = e.id
+	var provenance []domain.FieldProvenance
+	record := func(field string, obs Observation) {
+		provenance = append(provenance, domain.FieldProvenance{Field: field, Source: obs.Source, ObservedAt: obs.ObservedAt})
+	}
+	first := func(has func(m *domain.Match) bool) (Observation, bool) {
+		for _, obs := range fresh {
+			if has(obs.Match) {
+				return obs, true
+			}
+		}
+		return Observation{}, false
+	}
+
+	if obs, ok := first(func(m *domain.Match) bool { return m.Player1ID != "" && m.Player2ID != "" }); ok {
+		merged.Player1ID, merged.Player2ID = obs.Match.Player1ID, obs.Match.Player2ID
+		merged.Player1, merged.Player2 = obs.Match.Player1, obs.Match.Player2
+		record(FieldPlayers, obs)
+	}
+	if obs, ok := first(func(m *domain.Match) bool { return m.TournamentID != "" }); ok {
+		merged.TournamentID, merged.Tournament = obs.Match.TournamentID, obs.Match.Tournament
+		record(FieldTournament, obs)
+	}
+	if obs, ok := first(func(m *domain.Match) bool { return m.Round != "" }); ok {
+		merged.Round = obs.Match.Round
+		record(FieldRound, obs)
+	}
+	// A court's order only makes sense with the source's own court
+	if obs, ok := first(func(m *domain.Match) bool { return m.Court != "" }); ok {
+		merged.Court, merged.CourtOrder = obs.Match.Court, obs.Match.CourtOrder
+		record(FieldCourt, obs)
+	}
+	if obs, ok := first(func(m *domain.Match) bool { return m.NotBefore != "" }); ok {
+		merged.NotBefore = obs.Match.NotBefore
+		record(FieldNotBefore, obs)
+	}
+	if obs, ok := first(func(m *domain.Match) bool { return !m.StartTime.IsZero() }); ok {
+		merged.StartTime = obs.Match.StartTime
+		record(FieldStartTime, obs)
+	}
+	if obs, ok := first(func(m *domain.Match) bool { return m.Stats != (domain.MatchStats{}) }); ok {
+		merged.Stats = obs.Match.Stats
+		record(FieldStats, obs)
+	}
+
+	// Status: the furthest along wins, ties go to the more trusted source
+	status := fresh[0]
+	for _, obs := range fresh[1:] {
+		if statusRank(obs.Match.Status) > statusRank(status.Match.Status) {
+			status = obs
+		}
+	}
+	merged.Status = status.Match.Status
+	merged.EndTime, merged.DurationMinutes = status.Match.EndTime, status.Match.DurationMinutes
+	record(FieldStatus, status)
+
+	// Score: the most advanced score that is valid for this match
+	setsToWin := scoring.SetsToWin(&merged)
+	var score *Observation
+	for i, obs := range fresh {
+		if !scoring.ValidScore(obs.Match.Score, setsToWin) {
+			continue
+		}
+		if score == nil || scoring.Progress(obs.Match.Score) > scoring.Progress(score.Match.Score) {
+			score = &fresh[i]
+		}
+	}
+	if score != nil {
+		merged.Score, merged.Sets = score.Match.Score, score.Match.Sets
+		record(FieldScore, *score)
+	}
+
+	// Winner: sources name players by their own IDs, so map by side
+	merged.WinnerID = nil
+	if merged.Status == domain.StatusFinished {
+		if obs, ok := first(func(m *domain.Match) bool { return m.WinnerID != nil }); ok {
+			winner := merged.Player1ID
+			if *obs.Match.WinnerID == obs.Match.Player2ID {
+				winner = merged.Player2ID
+			}
+			merged.WinnerID = &winner
+			record(FieldWinner, obs)
+		}
+	}
+
+	sort.Slice(provenance, func(i, j int) bool { return provenance[i].Field < provenance[j].Field })
+	return &merged, provenance
+}
+
+func statusRank(status domain.MatchStatus) int {
+	switch status {
+	case domain.StatusLive:
+		return 1
+	case domain.StatusFinished:
+		return 2
+	}
+	return 0
+}
@@ -0,0 +1,153 @@
+package reconcile
+
+import (
+	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+func liveMatch(id, p1, p2 string, score domain.ScoreState) *domain.Match {
+	return &domain.Match{
+		ID:        id,
+		Player1ID: domain.PlayerIDFromName(p1),
+		Player2ID: domain.PlayerIDFromName(p2),
+		Player1:   &domain.Player{ID: domain.PlayerIDFromName(p1), Name: p1},
+		Player2:   &domain.Player{ID: domain.PlayerIDFromName(p2), Name: p2},
+		Status:    domain.StatusLive,
+		Score:     score,
+	}
+}
+
+func provenanceOf(result Result, field string) string {
+	for _, p := range result.Provenance {
+		if p.Field == field {
+			return p.Source
+		}
+	}
+	return ""
+}
+
+func TestReconciler_MostAdvancedValidScoreWins(t *testing.T) {
+	r := NewReconciler(DefaultPriority, DefaultMaxAge)
+	now := time.Now()
+
+	sofa := liveMatch("sofa_1", "Jannik Sinner", "Carlos Alcaraz", domain.ScoreState{GamesP1: 2, GamesP2: 1, PointsP1: "15"})
+	sofa.Round = "F"
+	r.Observe("sofascore", sofa, now)
+
+	// FlashScore lists the players the other way round and is a game ahead
+	flash := liveMatch("live-alcaraz-vs-sinner", "Alcaraz C.", "Sinner J.", domain.ScoreState{GamesP1: 1, GamesP2: 3})
+	result := r.Observe("flashscore", flash, now.Add(10*time.Second))
+
+	if result.Match.ID != "sofa_1" {
+		t.Errorf("Expected the first ID to stick, got %s", result.Match.ID)
+	}
+	if result.Match.Score.GamesP1 != 3 || result.Match.Score.GamesP2 != 1 {
+		t.Errorf("Expected the more advanced 3-1 in Sinner's favour, got %+v", result.Match.Score)
+	}
+	if got := provenanceOf(result, FieldScore); got != "flashscore" {
+		t.Errorf("Expected score from flashscore, got %q", got)
+	}
+	if got := provenanceOf(result, FieldPlayers); got != "sofascore" || result.Match.Player1.Name != "Jannik Sinner" {
+		t.Errorf("Expected players from sofascore, got %q", got)
+	}
+	if result.Match.Round != "F" {
+		t.Errorf("Expected round from sofascore, got %q", result.Match.Round)
+	}
+
+	// An impossible score never wins, however far along it looks
+	atp := liveMatch("atp-1", "Jannik Sinner", "Carlos Alcaraz", domain.ScoreState{SetsP1: 5})
+	result = r.Observe("atp", atp, now.Add(20*time.Second))
+	if got := provenanceOf(result, FieldScore); got != "flashscore" {
+		t.Errorf("Expected invalid atp score to be ignored, got score from %q", got)
+	}
+}
+
+func TestReconciler_StaleObservationsAndWinner(t *testing.T) {
+	r := NewReconciler(DefaultPriority, time.Minute)
+	now := time.Now()
+
+	r.Observe("sofascore", liveMatch("sofa_1", "Jannik Sinner", "Carlos Alcaraz", domain.ScoreState{SetsP1: 1, GamesP1: 5}), now)
+
+	finished := liveMatch("atp-1", "Jannik Sinner", "Carlos Alcaraz", domain.ScoreState{SetsP1: 2})
+	finished.Status = domain.StatusFinished
+	winner := finished.Player1ID
+	finished.WinnerID = &winner
+	result := r.Observe("atp", finished, now.Add(5*time.Minute))
+
+	// The sofascore report is too old to count, so the lower-priority source wins
+	if result.Match.Status != domain.StatusFinished || provenanceOf(result, FieldStatus) != "atp" {
+		t.Errorf("Expected finished status from atp, got %s from %q", result.Match.Status, provenanceOf(result, FieldStatus))
+	}
+	if result.Match.WinnerID == nil || *result.Match.WinnerID != result.Match.Player1ID {
+		t.Errorf("Expected player 1 to win, got %v", result.Match.WinnerID)
+	}
+
+	observations, provenance := r.Observations("sofa_1")
+	if len(observations) != 2 || len(provenance) == 0 {
+		t.Errorf("Expected two observations with provenance, got %d and %d", len(observations), len(provenance))
+	}
+
+	r.Prune(now.Add(time.Hour))
+	if observations, _ := r.Observations("sofa_1"); observations != nil {
+		t.Error("Expected pruned match to be forgotten")
+	}
+}
+
+func TestReconciler_KeepsSameNamedMatchesApart(t *testing.T) {
+	r := NewReconciler(DefaultPriority, DefaultMaxAge)
+	now := time.Now()
+	day := time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC)
+
+	observe := func(source, id, tournament string, start time.Time) Result {
+		m := liveMatch(id, "Jannik Sinner", "Carlos Alcaraz", domain.ScoreState{GamesP1: 1})
+		m.TournamentID, m.StartTime = tournament, start
+		return r.Observe(source, m, now)
+	}
+
+	observe("sofascore", "sofa_1", "aus-open-2025", day)
+	if got := observe("sofascore", "sofa_2", "aus-open-2025-women-singles", day).Match.ID; got != "sofa_2" {
+		t.Errorf("Expected another draw's match to stay apart, got %s", got)
+	}
+	if got := observe("sofascore", "sofa_3", "aus-open-2025", day.Add(48*time.Hour)).Match.ID; got != "sofa_3" {
+		t.Errorf("Expected another day's match to stay apart, got %s", got)
+	}
+
+	// A source that reports neither joins the match it can belong to
+	if got := observe("flashscore", "live-sinner-vs-alcaraz", "", time.Time{}).Match.ID; got != "sofa_1" && got != "sofa_2" && got != "sofa_3" {
+		t.Errorf("Expected a report without tournament or day to join a known match, got %s", got)
+	}
+	if got := observe("atp", "atp-1", "aus-open-2025", day.Add(2*time.Hour)); got.Match.ID != "sofa_1" {
+		t.Errorf("Expected the same tournament and day to join sofa_1, got %s", got.Match.ID)
+	}
+}
+
+func TestReconciler_ScrapedReportsAcrossMidnight(t *testing.T) {
+	r := NewReconciler(DefaultPriority, DefaultMaxAge)
+	start := time.Date(2025, 1, 20, 23, 30, 0, 0, time.UTC)
+
+	sofa := liveMatch("sofa_1", "Jannik Sinner", "Carlos Alcaraz", domain.ScoreState{GamesP1: 1})
+	sofa.TournamentID, sofa.StartTime = "aus-open-2025", start
+	r.Observe("sofascore", sofa, start)
+
+	// Scraped reports have no start time, and their IDs change with the
+	// poll day; polls on either side of midnight still join sofa_1
+	for _, polled := range []time.Time{start.Add(20 * time.Minute), start.Add(40 * time.Minute)} {
+		id := "live-alcaraz-vs-sinner-" + polled.Format("20060102")
+		if got := r.Observe("atp", liveMatch(id, "Alcaraz C.", "Sinner J.", domain.ScoreState{GamesP2: 2}), polled); got.Match.ID != "sofa_1" {
+			t.Errorf("Expected the report polled at %s to join sofa_1, got %s", polled.Format(time.Kitchen), got.Match.ID)
+		}
+	}
+}
+
+func TestSurname(t *testing.T) {
+	for _, name := range []string{"Jannik Sinner", "J. Sinner", "Sinner J.", "SINNER"} {
+		if got := Surname(name); got != "sinner" {
+			t.Errorf("Surname(%q) = %q, want sinner", name, got)
+		}
+	}
+	if got := Surname("de Minaur A."); got != "minaur" {
+		t.Errorf("Expected minaur, got %q", got)
+	}
+}
@@ -0,0 +1,408 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"hardcourt/backend/internal/database"
+	"hardcourt/backend/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// BulkBatch is a set of rows written together by a BulkWriter
+type BulkBatch struct {
+	Tournaments []*domain.Tournament
+	Players     []*domain.Player
+	Matches     []*domain.Match // With their stats and sets
+	Draws       []*domain.TournamentDraw
+
+	// MergePlayers only fills in details stored players lack, as
+	// PlayerRepository.Merge does, rather than overwriting them as Create does
+	MergePlayers bool
+
+	// ReplaceStartTimes overwrites stored start times, for sources that are
+	// authoritative about when matches were played. Otherwise a start time
+	// only moves while the match is scheduled, as MatchRepository.Update does.
+	ReplaceStartTimes bool
+}
+
+// Empty reports whether the batch has no rows
+func (b *BulkBatch) Empty() bool {
+	return len(b.Tournaments) == 0 && len(b.Players) == 0 && len(b.Matches) == 0 && len(b.Draws) == 0
+}
+
+// BulkResult counts the rows a bulk write merged
+type BulkResult struct {
+	Tournaments     int `json:"tournaments"`
+	Players         int `json:"players"`
+	MatchesInserted int `json:"matches_inserted"`
+	MatchesUpdated  int `json:"matches_updated"`
+	Draws           int `json:"draws"`
+}
+
+// BulkWriter writes large batches in one transaction: rows are copied into
+// temporary staging tables with COPY, then merged into the real tables with
+// one statement each. Either the whole batch is stored or none of it.
+//
+// Merges follow the single-row writes: tournaments as Upsert, players as
+// Create (or Merge), matches as Create or Update. Stats are only replaced
+// when the batch has some for the match, and a match's stored sets are
+// replaced by the batch's when it has any.
+type BulkWriter struct {
+	db *database.DB
+}
+
+func NewBulkWriter(db *database.DB) *BulkWriter {
+	return &BulkWriter{db: db}
+}
+
+// stagingTables are created per transaction and dropped at commit
+var stagingTables = []string{
+	`CREATE TEMP TABLE bulk_tournaments (
+		id TEXT, name TEXT, surface TEXT, city TEXT, country TEXT, category TEXT, year INT,
+		source TEXT, external_id TEXT, season_id TEXT, start_date DATE, end_date DATE, seq INT
+	) ON COMMIT DROP`,
+	`CREATE TEMP TABLE bulk_players (
+		id TEXT, name TEXT, country_code TEXT, rank INT, height_cm INT, plays TEXT, seq INT
+	) ON COMMIT DROP`,
+	`CREATE TEMP TABLE bulk_matches (
+		id TEXT, tournament_id TEXT, player1_id TEXT, player2_id TEXT, status TEXT,
+		start_time TIMESTAMPTZ, is_simulated BOOLEAN,
+		sets_p1 INT, sets_p2 INT, games_p1 INT, games_p2 INT, points_p1 TEXT, points_p2 TEXT, serving INT,
+		win_prob_p1 DOUBLE PRECISION, leverage_index DOUBLE PRECISION,
+		fatigue_p1 DOUBLE PRECISION, fatigue_p2 DOUBLE PRECISION, round TEXT,
+		court TEXT, court_order INT, not_before TEXT,
+		winner_id TEXT, duration_minutes INT, seed_p1 INT, seed_p2 INT, seq INT
+	) ON COMMIT DROP`,
+	`CREATE TEMP TABLE bulk_match_stats (
+		match_id TEXT, aces_p1 INT, aces_p2 INT, df_p1 INT, df_p2 INT,
+		break_points_p1 INT, break_points_p2 INT, winners_p1 INT, winners_p2 INT,
+		unforced_errors_p1 INT, unforced_errors_p2 INT,
+		first_serve_pct_p1 DOUBLE PRECISION, first_serve_pct_p2 DOUBLE PRECISION,
+		rally_count INT, recorded BOOLEAN, seq INT
+	) ON COMMIT DROP`,
+	`CREATE TEMP TABLE bulk_match_sets (
+		match_id TEXT, set_number INT, games_p1 INT, games_p2 INT, tiebreak_p1 INT, tiebreak_p2 INT, seq INT
+	) ON COMMIT DROP`,
+	`CREATE TEMP TABLE bulk_draws (
+		tournament_id TEXT, round TEXT, position INT, player_id TEXT, seed INT, bye BOOLEAN, entry_type TEXT, seq INT
+	) ON COMMIT DROP`,
+}
+
+// Write stores a batch atomically
+func (w *BulkWriter) Write(ctx context.Context, batch *BulkBatch) (BulkResult, error) {
+	var result BulkResult
+	if batch.Empty() {
+		return result, nil
+	}
+
+	tx, err := w.db.Pool.Begin(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to begin bulk write: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, ddl := range stagingTables {
+		if _, err := tx.Exec(ctx, ddl); err != nil {
+			return result, fmt.Errorf("failed to create staging table: %w", err)
+		}
+	}
+
+	if result.Players, err = w.mergePlayers(ctx, tx, batch.Players, batch.MergePlayers); err != nil {
+		return result, err
+	}
+	if result.Tournaments, err = w.mergeTournaments(ctx, tx, batch.Tournaments); err != nil {
+		return result, err
+	}
+	if result.MatchesInserted, result.MatchesUpdated, err = w.mergeMatches(ctx, tx, batch.Matches, batch.ReplaceStartTimes); err != nil {
+		return result, err
+	}
+	if result.Draws, err = w.mergeDraws(ctx, tx, batch.Draws); err != nil {
+		return result, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return BulkResult{}, fmt.Errorf("failed to commit bulk write: %w", err)
+	}
+	return result, nil
+}
+
+// copyRows copies rows into a staging table, numbering them in a seq column
+// so that the merge keeps the last of several rows for the same key
+func copyRows(ctx context.Context, tx pgx.Tx, table string, columns []string, rows [][]interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	for i := range rows {
+		rows[i] = append(rows[i], i)
+	}
+	columns = append(columns, "seq")
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{table}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("failed to copy into %s: %w", table, err)
+	}
+	return nil
+}
+
+func (w *BulkWriter) mergeTournaments(ctx context.Context, tx pgx.Tx, tournaments []*domain.Tournament) (int, error) {
+	rows := make([][]interface{}, 0, len(tournaments))
+	for _, t := range tournaments {
+		// The same placeholders Upsert ignores
+		name, city := t.Name, t.City
+		if name == t.ID {
+			name = ""
+		}
+		if city == "Unknown" {
+			city = ""
+		}
+		rows = append(rows, []interface{}{
+			t.ID, name, t.Surface, city, t.Country, t.Category, t.Year,
+			t.Source, t.ExternalID, t.SeasonID, t.StartDate, t.EndDate,
+		})
+	}
+	columns := []string{"id", "name", "surface", "city", "country", "category", "year",
+		"source", "external_id", "season_id", "start_date", "end_date"}
+	if err := copyRows(ctx, tx, "bulk_tournaments", columns, rows); err != nil {
+		return 0, err
+	}
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO tournaments (id, name, surface, city, country, category, year, source, external_id, season_id,
+			start_date, end_date)
+		SELECT DISTINCT ON (id) id, COALESCE(NULLIF(name, ''), id), surface, city, NULLIF(country, ''),
+			NULLIF(category, ''), NULLIF(year, 0), NULLIF(source, ''), NULLIF(external_id, ''), NULLIF(season_id, ''),
+			start_date, end_date
+		FROM bulk_tournaments
+		ORDER BY id, seq DESC
+		ON CONFLICT (id) DO UPDATE SET
+			name = CASE WHEN EXCLUDED.name = EXCLUDED.id THEN tournaments.name ELSE EXCLUDED.name END,
+			surface = COALESCE(NULLIF(EXCLUDED.surface, ''), tournaments.surface),
+			city = COALESCE(NULLIF(EXCLUDED.city, ''), tournaments.city),
+			country = COALESCE(EXCLUDED.country, tournaments.country),
+			category = COALESCE(EXCLUDED.category, tournaments.category),
+			year = COALESCE(EXCLUDED.year, tournaments.year),
+			source = COALESCE(EXCLUDED.source, tournaments.source),
+			external_id = COALESCE(EXCLUDED.external_id, tournaments.external_id),
+			season_id = COALESCE(EXCLUDED.season_id, tournaments.season_id),
+			start_date = COALESCE(EXCLUDED.start_date, tournaments.start_date),
+			end_date = COALESCE(EXCLUDED.end_date, tournaments.end_date),
+			updated_at = NOW()
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to merge tournaments: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (w *BulkWriter) mergePlayers(ctx context.Context, tx pgx.Tx, players []*domain.Player, merge bool) (int, error) {
+	rows := make([][]interface{}, 0, len(players))
+	for _, p := range players {
+		rows = append(rows, []interface{}{p.ID, p.Name, p.CountryCode, p.Rank, p.HeightCm, p.Plays})
+	}
+	columns := []string{"id", "name", "country_code", "rank", "height_cm", "plays"}
+	if err := copyRows(ctx, tx, "bulk_players", columns, rows); err != nil {
+		return 0, err
+	}
+
+	// Create overwrites name, country and rank; Merge only fills in gaps
+	conflict := `
+		name = EXCLUDED.name,
+		country_code = EXCLUDED.country_code,
+		rank = EXCLUDED.rank
+	`
+	if merge {
+		conflict = `
+			country_code = CASE WHEN players.country_code = 'XX' THEN EXCLUDED.country_code ELSE players.country_code END,
+			height_cm = COALESCE(players.height_cm, EXCLUDED.height_cm),
+			plays = COALESCE(players.plays, EXCLUDED.plays)
+		`
+	}
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO players (id, name, country_code, rank, height_cm, plays)
+		SELECT DISTINCT ON (id) id, name, country_code, rank, NULLIF(height_cm, 0), NULLIF(plays, '')
+		FROM bulk_players
+		ORDER BY id, seq DESC
+		ON CONFLICT (id) DO UPDATE SET `+conflict)
+	if err != nil {
+		return 0, fmt.Errorf("failed to merge players: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (w *BulkWriter) mergeMatches(ctx context.Context, tx pgx.Tx, matches []*domain.Match, replaceStartTimes bool) (inserted, updated int, err error) {
+	var matchRows, statRows, setRows [][]interface{}
+	for _, m := range matches {
+		matchRows = append(matchRows, []interface{}{
+			m.ID, m.TournamentID, m.Player1ID, m.Player2ID, string(m.Status),
+			m.StartTime, m.IsSimulated,
+			m.Score.SetsP1, m.Score.SetsP2, m.Score.GamesP1, m.Score.GamesP2,
+			m.Score.PointsP1, m.Score.PointsP2, m.Score.Serving,
+			m.WinProbP1, m.LeverageIndex, m.FatigueP1, m.FatigueP2, m.Round,
+			m.Court, m.CourtOrder, m.NotBefore,
+			m.WinnerID, m.DurationMinutes, m.SeedP1, m.SeedP2,
+		})
+		s := m.Stats
+		statRows = append(statRows, []interface{}{
+			m.ID, s.AcesP1, s.AcesP2, s.DoubleFaultsP1, s.DoubleFaultsP2,
+			s.BreakPointsP1, s.BreakPointsP2, s.WinnersP1, s.WinnersP2,
+			s.UnforcedErrorsP1, s.UnforcedErrorsP2, s.FirstServePctP1, s.FirstServePctP2,
+			s.RallyCount, s != domain.MatchStats{},
+		})
+		for _, set := range m.Sets {
+			setRows = append(setRows, []interface{}{m.ID, set.SetNumber, set.GamesP1, set.GamesP2, set.TiebreakP1, set.TiebreakP2})
+		}
+	}
+
+	err = copyRows(ctx, tx, "bulk_matches", []string{
+		"id", "tournament_id", "player1_id", "player2_id", "status", "start_time", "is_simulated",
+		"sets_p1", "sets_p2", "games_p1", "games_p2", "points_p1", "points_p2", "serving",
+		"win_prob_p1", "leverage_index", "fatigue_p1", "fatigue_p2", "round",
+		"court", "court_order", "not_before", "winner_id", "duration_minutes", "seed_p1", "seed_p2",
+	}, matchRows)
+	if err != nil {
+		return 0, 0, err
+	}
+	err = copyRows(ctx, tx, "bulk_match_stats", []string{
+		"match_id", "aces_p1", "aces_p2", "df_p1", "df_p2", "break_points_p1", "break_points_p2",
+		"winners_p1", "winners_p2", "unforced_errors_p1", "unforced_errors_p2",
+		"first_serve_pct_p1", "first_serve_pct_p2", "rally_count", "recorded",
+	}, statRows)
+	if err != nil {
+		return 0, 0, err
+	}
+	err = copyRows(ctx, tx, "bulk_match_sets", []string{
+		"match_id", "set_number", "games_p1", "games_p2", "tiebreak_p1", "tiebreak_p2",
+	}, setRows)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(matchRows) == 0 {
+		return 0, 0, nil
+	}
+
+	// As Update: blanks keep what is stored
+	rows, err := tx.Query(ctx, `
+		INSERT INTO matches (
+			id, tournament_id, player1_id, player2_id, status, start_time, is_simulated,
+			sets_p1, sets_p2, games_p1, games_p2, points_p1, points_p2, serving,
+			win_prob_p1, leverage_index, fatigue_p1, fatigue_p2, round,
+			court, court_order, not_before,
+			winner_id, duration_minutes, seed_p1, seed_p2
+		)
+		SELECT DISTINCT ON (id)
+			id, tournament_id, player1_id, player2_id, status, start_time, is_simulated,
+			sets_p1, sets_p2, games_p1, games_p2, points_p1, points_p2, serving,
+			win_prob_p1, leverage_index, fatigue_p1, fatigue_p2, NULLIF(round, ''),
+			NULLIF(court, ''), court_order, NULLIF(not_before, ''),
+			winner_id, NULLIF(duration_minutes, 0), NULLIF(seed_p1, 0), NULLIF(seed_p2, 0)
+		FROM bulk_matches
+		ORDER BY id, seq DESC
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status, winner_id = EXCLUDED.winner_id,
+			sets_p1 = EXCLUDED.sets_p1, sets_p2 = EXCLUDED.sets_p2,
+			games_p1 = EXCLUDED.games_p1, games_p2 = EXCLUDED.games_p2,
+			points_p1 = EXCLUDED.points_p1, points_p2 = EXCLUDED.points_p2, serving = EXCLUDED.serving,
+			win_prob_p1 = EXCLUDED.win_prob_p1, leverage_index = EXCLUDED.leverage_index,
+			fatigue_p1 = EXCLUDED.fatigue_p1, fatigue_p2 = EXCLUDED.fatigue_p2,
+			round = COALESCE(EXCLUDED.round, matches.round),
+			court = COALESCE(EXCLUDED.court, matches.court),
+			court_order = CASE WHEN EXCLUDED.court_order > 0 THEN EXCLUDED.court_order ELSE matches.court_order END,
+			not_before = COALESCE(EXCLUDED.not_before, matches.not_before),
+			start_time = CASE WHEN matches.status = 'Scheduled' OR $1
+				THEN EXCLUDED.start_time ELSE matches.start_time END,
+			duration_minutes = COALESCE(EXCLUDED.duration_minutes, matches.duration_minutes),
+			seed_p1 = COALESCE(EXCLUDED.seed_p1, matches.seed_p1),
+			seed_p2 = COALESCE(EXCLUDED.seed_p2, matches.seed_p2),
+			updated_at = NOW()
+		RETURNING xmax = 0
+	`, replaceStartTimes)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to merge matches: %w", err)
+	}
+	for rows.Next() {
+		var isInsert bool
+		if err := rows.Scan(&isInsert); err != nil {
+			rows.Close()
+			return 0, 0, fmt.Errorf("failed to read merged match: %w", err)
+		}
+		if isInsert {
+			inserted++
+		} else {
+			updated++
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, 0, fmt.Errorf("failed to merge matches: %w", err)
+	}
+
+	statements := []struct{ query, what string }{
+		{`
+			INSERT INTO match_stats (match_id)
+			SELECT DISTINCT match_id FROM bulk_match_stats
+			ON CONFLICT (match_id) DO NOTHING
+		`, "stats"},
+		{`
+			UPDATE match_stats s SET
+				aces_p1 = b.aces_p1, aces_p2 = b.aces_p2, df_p1 = b.df_p1, df_p2 = b.df_p2,
+				break_points_p1 = b.break_points_p1, break_points_p2 = b.break_points_p2,
+				winners_p1 = b.winners_p1, winners_p2 = b.winners_p2,
+				unforced_errors_p1 = b.unforced_errors_p1, unforced_errors_p2 = b.unforced_errors_p2,
+				first_serve_pct_p1 = b.first_serve_pct_p1, first_serve_pct_p2 = b.first_serve_pct_p2,
+				rally_count = b.rally_count, updated_at = NOW()
+			FROM (SELECT DISTINCT ON (match_id) * FROM bulk_match_stats WHERE recorded ORDER BY match_id, seq DESC) b
+			WHERE s.match_id = b.match_id
+		`, "stats"},
+		{`
+			DELETE FROM match_sets s
+			USING (SELECT match_id, MAX(set_number) AS sets FROM bulk_match_sets GROUP BY match_id) b
+			WHERE s.match_id = b.match_id AND s.set_number > b.sets
+		`, "old sets"},
+		{`
+			INSERT INTO match_sets (match_id, set_number, games_p1, games_p2, tiebreak_p1, tiebreak_p2)
+			SELECT DISTINCT ON (match_id, set_number) match_id, set_number, games_p1, games_p2, tiebreak_p1, tiebreak_p2
+			FROM bulk_match_sets
+			ORDER BY match_id, set_number, seq DESC
+			ON CONFLICT (match_id, set_number) DO UPDATE SET
+				games_p1 = EXCLUDED.games_p1,
+				games_p2 = EXCLUDED.games_p2,
+				tiebreak_p1 = EXCLUDED.tiebreak_p1,
+				tiebreak_p2 = EXCLUDED.tiebreak_p2
+		`, "sets"},
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(ctx, statement.query); err != nil {
+			return 0, 0, fmt.Errorf("failed to merge match %s: %w", statement.what, err)
+		}
+	}
+
+	return inserted, updated, nil
+}
+
+func (w *BulkWriter) mergeDraws(ctx context.Context, tx pgx.Tx, draws []*domain.TournamentDraw) (int, error) {
+	rows := make([][]interface{}, 0, len(draws))
+	for _, d := range draws {
+		rows = append(rows, []interface{}{d.TournamentID, d.Round, d.Position, d.PlayerID, d.Seed, d.Bye, d.EntryType})
+	}
+	columns := []string{"tournament_id", "round", "position", "player_id", "seed", "bye", "entry_type"}
+	if err := copyRows(ctx, tx, "bulk_draws", columns, rows); err != nil {
+		return 0, err
+	}
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO tournament_draws (tournament_id, round, position, player_id, seed, bye, entry_type)
+		SELECT DISTINCT ON (tournament_id, round, position)
+			tournament_id, round, position, player_id, seed, bye, NULLIF(entry_type, '')
+		FROM bulk_draws
+		ORDER BY tournament_id, round, position, seq DESC
+		ON CONFLICT (tournament_id, round, position) DO UPDATE SET
+			player_id = EXCLUDED.player_id,
+			seed = EXCLUDED.seed,
+			bye = EXCLUDED.bye,
+			entry_type = EXCLUDED.entry_type
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to merge draws: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"hardcourt/backend/internal/database"
+	"hardcourt/backend/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrForecastNotFound = errors.New("forecast not found")
+
+type ForecastRepository struct {
+	db *database.DB
+}
+
+func NewForecastRepository(db *database.DB) *ForecastRepository {
+	return &ForecastRepository{db: db}
+}
+
+// Save stores a forecast run and sets its ID and creation time
+func (r *ForecastRepository) Save(ctx context.Context, forecast *domain.TournamentForecast) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin forecast transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO tournament_forecasts (tournament_id, simulations, trigger_match_id)
+		VALUES ($1, $2, NULLIF($3, ''))
+		RETURNING id, created_at
+	`
+	err = tx.QueryRow(ctx, query, forecast.TournamentID, forecast.Simulations, forecast.TriggerMatchID).
+		Scan(&forecast.ID, &forecast.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save forecast: %w", err)
+	}
+
+	batch := &pgx.Batch{}
+	for place, p := range forecast.Players {
+		chances := map[string]float64{domain.TitleRound: p.Win}
+		for round, probability := range p.Reach {
+			chances[round] = probability
+		}
+		for round, probability := range chances {
+			batch.Queue(`
+				INSERT INTO tournament_forecast_players (forecast_id, player_id, round, probability, place)
+				VALUES ($1, $2, $3, $4, $5)`,
+				forecast.ID, p.PlayerID, round, probability, place+1,
+			)
+		}
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to save forecast players: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit forecast: %w", err)
+	}
+	return nil
+}
+
+// GetStale returns the tournaments with a draw whose latest finished match
+// among the given rounds was stored after their latest forecast, or that
+// have never been forecast, with that match
+func (r *ForecastRepository) GetStale(ctx context.Context, rounds []string) (map[string]string, error) {
+	query := `
+		SELECT DISTINCT ON (m.tournament_id) m.tournament_id, m.id
+		FROM matches m
+		WHERE m.status = $1 AND NOT COALESCE(m.is_simulated, FALSE) AND m.round = ANY($2)
+			AND EXISTS (SELECT 1 FROM tournament_draws d WHERE d.tournament_id = m.tournament_id)
+			AND m.updated_at > COALESCE(
+				(SELECT MAX(f.created_at) FROM tournament_forecasts f WHERE f.tournament_id = m.tournament_id),
+				'-infinity')
+		ORDER BY m.tournament_id, m.updated_at DESC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, domain.StatusFinished, rounds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stale forecasts: %w", err)
+	}
+	defer rows.Close()
+
+	stale := make(map[string]string)
+	for rows.Next() {
+		var tournamentID, matchID string
+		if err := rows.Scan(&tournamentID, &matchID); err != nil {
+			return nil, fmt.Errorf("failed to scan stale forecast: %w", err)
+		}
+		stale[tournamentID] = matchID
+	}
+	return stale, rows.Err()
+}
+
+// GetLatest retrieves a tournament's most recent forecast
+func (r *ForecastRepository) GetLatest(ctx context.Context, tournamentID string) (*domain.TournamentForecast, error) {
+	query := `
+		SELECT id, tournament_id, simulations, COALESCE(trigger_match_id, ''), created_at
+		FROM tournament_forecasts
+		WHERE tournament_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT 1
+	`
+
+	forecast := &domain.TournamentForecast{Players: []domain.PlayerForecast{}}
+	err := r.db.Pool.QueryRow(ctx, query, tournamentID).Scan(
+		&forecast.ID, &forecast.TournamentID, &forecast.Simulations, &forecast.TriggerMatchID, &forecast.CreatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("%w: %s", ErrForecastNotFound, tournamentID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get forecast: %w", err)
+	}
+
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT fp.player_id, COALESCE(p.name, ''), fp.round, fp.probability
+		FROM tournament_forecast_players fp
+		LEFT JOIN players p ON p.id = fp.player_id
+		WHERE fp.forecast_id = $1
+		ORDER BY fp.place, fp.round
+	`, forecast.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query forecast players: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var playerID, name, round string
+		var probability float64
+		if err := rows.Scan(&playerID, &name, &round, &probability); err != nil {
+			return nil, fmt.Errorf("failed to scan forecast player: %w", err)
+		}
+		last := len(forecast.Players) - 1
+		if last < 0 || forecast.Players[last].PlayerID != playerID {
+			forecast.Players = append(forecast.Players, domain.PlayerForecast{
+				PlayerID: playerID, Name: name, Reach: make(map[string]float64),
+			})
+			last++
+		}
+		if round == domain.TitleRound {
+			forecast.Players[last].Win = probability
+		} else {
+			forecast.Players[last].Reach[round] = probability
+		}
+	}
+	return forecast, rows.Err()
+}
+
+// GetHistory retrieves every forecast run of a tournament, oldest first,
+// with each player's title chance; playerID limits it to one player
+func (r *ForecastRepository) GetHistory(ctx context.Context, tournamentID, playerID string) ([]domain.ForecastSnapshot, error) {
+	query := `
+		SELECT f.id, f.created_at, COALESCE(f.trigger_match_id, ''), fp.player_id, fp.probability
+		FROM tournament_forecasts f
+		LEFT JOIN tournament_forecast_players fp
+			ON fp.forecast_id = f.id AND fp.round = $2 AND ($3 = '' OR fp.player_id = $3)
+		WHERE f.tournament_id = $1
+		ORDER BY f.created_at, f.id, fp.place
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, tournamentID, domain.TitleRound, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query forecast history: %w", err)
+	}
+	defer rows.Close()
+
+	history := []domain.ForecastSnapshot{}
+	for rows.Next() {
+		var snapshot domain.ForecastSnapshot
+		var player *string
+		var probability *float64
+		if err := rows.Scan(&snapshot.ID, &snapshot.CreatedAt, &snapshot.TriggerMatchID, &player, &probability); err != nil {
+			return nil, fmt.Errorf("failed to scan forecast history: %w", err)
+		}
+		if n := len(history); n == 0 || history[n-1].ID != snapshot.ID {
+			snapshot.Win = make(map[string]float64)
+			history = append(history, snapshot)
+		}
+		if player != nil && probability != nil {
+			history[len(history)-1].Win[*player] = *probability
+		}
+	}
+	return history, rows.Err()
+}
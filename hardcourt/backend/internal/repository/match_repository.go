@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"hardcourt/backend/internal/database"
 	"hardcourt/backend/internal/domain"
@@ -10,6 +12,9 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+// ErrMatchNotFound is returned by GetByID for unknown or simulated matches
+var ErrMatchNotFound = errors.New("match not found")
+
 type MatchRepository struct {
 	db *database.DB
 }
@@ -24,8 +29,12 @@ func (r *MatchRepository) Create(ctx context.Context, match *domain.Match) error
 		INSERT INTO matches (
 			id, tournament_id, player1_id, player2_id, status, start_time, is_simulated,
 			sets_p1, sets_p2, games_p1, games_p2, points_p1, points_p2, serving,
-			win_prob_p1, leverage_index, fatigue_p1, fatigue_p2
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+			win_prob_p1, leverage_index, fatigue_p1, fatigue_p2, round,
+			court, court_order, not_before,
+			winner_id, duration_minutes, seed_p1, seed_p2
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, NULLIF($19, ''),
+			NULLIF($20, ''), $21, NULLIF($22, ''),
+			$23, NULLIF($24, 0), NULLIF($25, 0), NULLIF($26, 0))
 		ON CONFLICT (id) DO NOTHING
 	`
 
@@ -38,6 +47,9 @@ func (r *MatchRepository) Create(ctx context.Context, match *domain.Match) error
 		match.Score.Serving,
 		match.WinProbP1, match.LeverageIndex,
 		match.FatigueP1, match.FatigueP2,
+		match.Round,
+		match.Court, match.CourtOrder, match.NotBefore,
+		match.WinnerID, match.DurationMinutes, match.SeedP1, match.SeedP2,
 	)
 
 	if err != nil {
@@ -46,8 +58,11 @@ func (r *MatchRepository) Create(ctx context.Context, match *domain.Match) error
 
 	// Create stats record
 	statsQuery := `
-		INSERT INTO match_stats (match_id, aces_p1, aces_p2, df_p1, df_p2, break_points_p1, break_points_p2, rally_count)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO match_stats (
+			match_id, aces_p1, aces_p2, df_p1, df_p2, break_points_p1, break_points_p2,
+			winners_p1, winners_p2, unforced_errors_p1, unforced_errors_p2,
+			first_serve_pct_p1, first_serve_pct_p2, rally_count
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		ON CONFLICT (match_id) DO NOTHING
 	`
 
@@ -56,14 +71,26 @@ func (r *MatchRepository) Create(ctx context.Context, match *domain.Match) error
 		match.Stats.AcesP1, match.Stats.AcesP2,
 		match.Stats.DoubleFaultsP1, match.Stats.DoubleFaultsP2,
 		match.Stats.BreakPointsP1, match.Stats.BreakPointsP2,
+		match.Stats.WinnersP1, match.Stats.WinnersP2,
+		match.Stats.UnforcedErrorsP1, match.Stats.UnforcedErrorsP2,
+		match.Stats.FirstServePctP1, match.Stats.FirstServePctP2,
 		match.Stats.RallyCount,
 	)
+	if err != nil {
+		return fmt.Errorf("failed to create match stats: %w", err)
+	}
 
-	return err
+	return r.saveSets(ctx, match)
 }
 
-// Update updates an existing match
+// Update updates an existing match. The start time only moves while the
+// match is still scheduled.
 func (r *MatchRepository) Update(ctx context.Context, match *domain.Match) error {
+	var startTime *time.Time
+	if !match.StartTime.IsZero() {
+		startTime = &match.StartTime
+	}
+
 	query := `
 		UPDATE matches SET
 			status = $2, winner_id = $3,
@@ -71,6 +98,14 @@ func (r *MatchRepository) Update(ctx context.Context, match *domain.Match) error
 			points_p1 = $8, points_p2 = $9, serving = $10,
 			win_prob_p1 = $11, leverage_index = $12,
 			fatigue_p1 = $13, fatigue_p2 = $14,
+			round = COALESCE(NULLIF($15, ''), round),
+			court = COALESCE(NULLIF($16, ''), court),
+			court_order = CASE WHEN $17 > 0 THEN $17 ELSE court_order END,
+			not_before = COALESCE(NULLIF($18, ''), not_before),
+			start_time = CASE WHEN status = 'Scheduled' THEN COALESCE($19, start_time) ELSE start_time END,
+			duration_minutes = COALESCE(NULLIF($20, 0), duration_minutes),
+			seed_p1 = COALESCE(NULLIF($21, 0), seed_p1),
+			seed_p2 = COALESCE(NULLIF($22, 0), seed_p2),
 			updated_at = NOW()
 		WHERE id = $1
 	`
@@ -83,6 +118,10 @@ func (r *MatchRepository) Update(ctx context.Context, match *domain.Match) error
 		match.Score.Serving,
 		match.WinProbP1, match.LeverageIndex,
 		match.FatigueP1, match.FatigueP2,
+		match.Round,
+		match.Court, match.CourtOrder, match.NotBefore,
+		startTime,
+		match.DurationMinutes, match.SeedP1, match.SeedP2,
 	)
 
 	if err != nil {
@@ -93,7 +132,11 @@ func (r *MatchRepository) Update(ctx context.Context, match *domain.Match) error
 	statsQuery := `
 		UPDATE match_stats SET
 			aces_p1 = $2, aces_p2 = $3, df_p1 = $4, df_p2 = $5,
-			break_points_p1 = $6, break_points_p2 = $7, rally_count = $8
+			break_points_p1 = $6, break_points_p2 = $7,
+			winners_p1 = $8, winners_p2 = $9,
+			unforced_errors_p1 = $10, unforced_errors_p2 = $11,
+			first_serve_pct_p1 = $12, first_serve_pct_p2 = $13,
+			rally_count = $14, updated_at = NOW()
 		WHERE match_id = $1
 	`
 
@@ -102,38 +145,114 @@ func (r *MatchRepository) Update(ctx context.Context, match *domain.Match) error
 		match.Stats.AcesP1, match.Stats.AcesP2,
 		match.Stats.DoubleFaultsP1, match.Stats.DoubleFaultsP2,
 		match.Stats.BreakPointsP1, match.Stats.BreakPointsP2,
+		match.Stats.WinnersP1, match.Stats.WinnersP2,
+		match.Stats.UnforcedErrorsP1, match.Stats.UnforcedErrorsP2,
+		match.Stats.FirstServePctP1, match.Stats.FirstServePctP2,
 		match.Stats.RallyCount,
 	)
+	if err != nil {
+		return fmt.Errorf("failed to update match stats: %w", err)
+	}
 
-	return err
+	return r.saveSets(ctx, match)
 }
 
-// GetByID retrieves a match by ID with player information
-func (r *MatchRepository) GetByID(ctx context.Context, id string) (*domain.Match, error) {
+// saveSets upserts the completed set scores of a match
+func (r *MatchRepository) saveSets(ctx context.Context, match *domain.Match) error {
 	query := `
-		SELECT
-			m.id, m.tournament_id, m.player1_id, m.player2_id, m.status, m.start_time, m.winner_id, m.is_simulated,
-			m.sets_p1, m.sets_p2, m.games_p1, m.games_p2, m.points_p1, m.points_p2, m.serving,
-			m.win_prob_p1, m.leverage_index, m.fatigue_p1, m.fatigue_p2,
-			p1.id, p1.name, p1.country_code, p1.rank,
-			p2.id, p2.name, p2.country_code, p2.rank,
-			COALESCE(s.aces_p1, 0), COALESCE(s.aces_p2, 0),
-			COALESCE(s.df_p1, 0), COALESCE(s.df_p2, 0),
-			COALESCE(s.break_points_p1, 0), COALESCE(s.break_points_p2, 0),
-			COALESCE(s.rally_count, 0)
-		FROM matches m
-		JOIN players p1 ON m.player1_id = p1.id
-		JOIN players p2 ON m.player2_id = p2.id
-		LEFT JOIN match_stats s ON m.id = s.match_id
-		WHERE m.id = $1 AND m.is_simulated = FALSE
+		INSERT INTO match_sets (match_id, set_number, games_p1, games_p2, tiebreak_p1, tiebreak_p2)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (match_id, set_number) DO UPDATE SET
+			games_p1 = EXCLUDED.games_p1,
+			games_p2 = EXCLUDED.games_p2,
+			tiebreak_p1 = EXCLUDED.tiebreak_p1,
+			tiebreak_p2 = EXCLUDED.tiebreak_p2
+	`
+
+	for _, set := range match.Sets {
+		_, err := r.db.Pool.Exec(ctx, query,
+			match.ID, set.SetNumber, set.GamesP1, set.GamesP2, set.TiebreakP1, set.TiebreakP2,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save set %d: %w", set.SetNumber, err)
+		}
+	}
+
+	return nil
+}
+
+// loadSets fills in the completed sets of the given matches
+func (r *MatchRepository) loadSets(ctx context.Context, matches ...*domain.Match) error {
+	if len(matches) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(matches))
+	byID := make(map[string]*domain.Match, len(matches))
+	for i, match := range matches {
+		ids[i] = match.ID
+		byID[match.ID] = match
+	}
+
+	query := `
+		SELECT match_id, set_number, games_p1, games_p2, COALESCE(tiebreak_p1, 0), COALESCE(tiebreak_p2, 0)
+		FROM match_sets
+		WHERE match_id = ANY($1)
+		ORDER BY match_id, set_number
 	`
 
+	rows, err := r.db.Pool.Query(ctx, query, ids)
+	if err != nil {
+		return fmt.Errorf("failed to query match sets: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var matchID string
+		var set domain.SetScore
+		if err := rows.Scan(&matchID, &set.SetNumber, &set.GamesP1, &set.GamesP2, &set.TiebreakP1, &set.TiebreakP2); err != nil {
+			return fmt.Errorf("failed to scan match set: %w", err)
+		}
+		if match, ok := byID[matchID]; ok {
+			match.Sets = append(match.Sets, set)
+		}
+	}
+
+	return rows.Err()
+}
+
+// matchSelect reads a match with its players and stats; callers add WHERE
+// and ORDER BY clauses
+const matchSelect = `
+	SELECT
+		m.id, m.tournament_id, m.player1_id, m.player2_id, m.status, m.start_time, m.winner_id, m.is_simulated,
+		m.sets_p1, m.sets_p2, m.games_p1, m.games_p2, m.points_p1, m.points_p2, m.serving,
+		m.win_prob_p1, m.leverage_index, m.fatigue_p1, m.fatigue_p2, COALESCE(m.round, ''),
+		COALESCE(m.court, ''), COALESCE(m.court_order, 0), COALESCE(m.not_before, ''),
+		COALESCE(m.duration_minutes, 0), COALESCE(m.seed_p1, 0), COALESCE(m.seed_p2, 0),
+		p1.id, p1.name, p1.country_code, p1.rank,
+		p2.id, p2.name, p2.country_code, p2.rank,
+		COALESCE(s.aces_p1, 0), COALESCE(s.aces_p2, 0),
+		COALESCE(s.df_p1, 0), COALESCE(s.df_p2, 0),
+		COALESCE(s.break_points_p1, 0), COALESCE(s.break_points_p2, 0),
+		COALESCE(s.winners_p1, 0), COALESCE(s.winners_p2, 0),
+		COALESCE(s.unforced_errors_p1, 0), COALESCE(s.unforced_errors_p2, 0),
+		COALESCE(s.first_serve_pct_p1, 0), COALESCE(s.first_serve_pct_p2, 0),
+		COALESCE(s.rally_count, 0)
+	FROM matches m
+	JOIN players p1 ON m.player1_id = p1.id
+	JOIN players p2 ON m.player2_id = p2.id
+	LEFT JOIN match_stats s ON m.id = s.match_id
+`
+
+// scanMatch reads one row of matchSelect
+func scanMatch(row pgx.Row) (*domain.Match, error) {
 	match := &domain.Match{
 		Player1: &domain.Player{},
 		Player2: &domain.Player{},
 	}
 
-	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
+	err := row.Scan(
 		&match.ID, &match.TournamentID, &match.Player1ID, &match.Player2ID,
 		&match.Status, &match.StartTime, &match.WinnerID, &match.IsSimulated,
 		&match.Score.SetsP1, &match.Score.SetsP2,
@@ -141,56 +260,124 @@ func (r *MatchRepository) GetByID(ctx context.Context, id string) (*domain.Match
 		&match.Score.PointsP1, &match.Score.PointsP2,
 		&match.Score.Serving,
 		&match.WinProbP1, &match.LeverageIndex,
-		&match.FatigueP1, &match.FatigueP2,
+		&match.FatigueP1, &match.FatigueP2, &match.Round,
+		&match.Court, &match.CourtOrder, &match.NotBefore,
+		&match.DurationMinutes, &match.SeedP1, &match.SeedP2,
 		&match.Player1.ID, &match.Player1.Name, &match.Player1.CountryCode, &match.Player1.Rank,
 		&match.Player2.ID, &match.Player2.Name, &match.Player2.CountryCode, &match.Player2.Rank,
 		&match.Stats.AcesP1, &match.Stats.AcesP2,
 		&match.Stats.DoubleFaultsP1, &match.Stats.DoubleFaultsP2,
 		&match.Stats.BreakPointsP1, &match.Stats.BreakPointsP2,
+		&match.Stats.WinnersP1, &match.Stats.WinnersP2,
+		&match.Stats.UnforcedErrorsP1, &match.Stats.UnforcedErrorsP2,
+		&match.Stats.FirstServePctP1, &match.Stats.FirstServePctP2,
 		&match.Stats.RallyCount,
 	)
+	if err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+// GetByID retrieves a match by ID with all related data (excludes simulated matches)
+func (r *MatchRepository) GetByID(ctx context.Context, id string) (*domain.Match, error) {
+	query := matchSelect + ` WHERE m.id = $1 AND m.is_simulated = FALSE`
 
+	match, err := scanMatch(r.db.Pool.QueryRow(ctx, query, id))
 	if err == pgx.ErrNoRows {
-		return nil, fmt.Errorf("match not found: %s", id)
+		return nil, fmt.Errorf("%w: %s", ErrMatchNotFound, id)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get match: %w", err)
 	}
 
+	if err := r.loadSets(ctx, match); err != nil {
+		return nil, err
+	}
+
 	return match, nil
 }
 
 // GetAll retrieves all matches with optional status filter (excludes simulated matches)
 func (r *MatchRepository) GetAll(ctx context.Context, status string) ([]*domain.Match, error) {
+	query := matchSelect + ` WHERE m.is_simulated = FALSE`
+
+	var args []interface{}
+	if status != "" {
+		query += " AND m.status = $1"
+		args = append(args, status)
+	}
+	query += " ORDER BY m.start_time DESC"
+
+	return r.queryMatches(ctx, query, args...)
+}
+
+// GetByTournament retrieves a tournament's matches starting in [from, to),
+// earliest first (excludes simulated matches)
+func (r *MatchRepository) GetByTournament(ctx context.Context, tournamentID string, from, to time.Time) ([]*domain.Match, error) {
+	query := matchSelect + `
+		WHERE m.tournament_id = $1 AND m.start_time >= $2 AND m.start_time < $3 AND m.is_simulated = FALSE
+		ORDER BY m.start_time, m.court_order, m.id`
+
+	return r.queryMatches(ctx, query, tournamentID, from, to)
+}
+
+// GetResults retrieves a tournament's finished matches in the order they
+// were played (excludes simulated matches)
+func (r *MatchRepository) GetResults(ctx context.Context, tournamentID string) ([]*domain.Match, error) {
+	return r.GetByStatus(ctx, tournamentID, domain.StatusFinished)
+}
+
+// GetByStatus retrieves a tournament's matches with any of the given
+// statuses, in start order (excludes simulated matches)
+func (r *MatchRepository) GetByStatus(ctx context.Context, tournamentID string, statuses ...domain.MatchStatus) ([]*domain.Match, error) {
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = string(status)
+	}
+
+	query := matchSelect + `
+		WHERE m.tournament_id = $1 AND m.status = ANY($2) AND m.is_simulated = FALSE
+		ORDER BY m.start_time, m.id`
+
+	return r.queryMatches(ctx, query, tournamentID, names)
+}
+
+// GetCompletedTournamentIDs returns the tournaments that are completed or
+// have a finished final, and have finished main draw matches
+func (r *MatchRepository) GetCompletedTournamentIDs(ctx context.Context) ([]string, error) {
 	query := `
-		SELECT
-			m.id, m.tournament_id, m.player1_id, m.player2_id, m.status, m.start_time, m.winner_id, m.is_simulated,
-			m.sets_p1, m.sets_p2, m.games_p1, m.games_p2, m.points_p1, m.points_p2, m.serving,
-			m.win_prob_p1, m.leverage_index, m.fatigue_p1, m.fatigue_p2,
-			p1.id, p1.name, p1.country_code, p1.rank,
-			p2.id, p2.name, p2.country_code, p2.rank,
-			COALESCE(s.aces_p1, 0), COALESCE(s.aces_p2, 0),
-			COALESCE(s.df_p1, 0), COALESCE(s.df_p2, 0),
-			COALESCE(s.break_points_p1, 0), COALESCE(s.break_points_p2, 0),
-			COALESCE(s.rally_count, 0)
+		SELECT DISTINCT m.tournament_id
 		FROM matches m
-		JOIN players p1 ON m.player1_id = p1.id
-		JOIN players p2 ON m.player2_id = p2.id
-		LEFT JOIN match_stats s ON m.id = s.match_id
-		WHERE m.is_simulated = FALSE
-	`
-
-	var rows pgx.Rows
-	var err error
+		JOIN tournaments t ON t.id = m.tournament_id
+		WHERE m.status = $1 AND m.is_simulated = FALSE
+			AND m.round IN ('R128', 'R64', 'R32', 'R16', 'QF', 'SF', 'F')
+			AND (t.status = 'completed' OR EXISTS (
+				SELECT 1 FROM matches f
+				WHERE f.tournament_id = t.id AND f.round = 'F' AND f.status = $1 AND f.is_simulated = FALSE
+			))
+		ORDER BY m.tournament_id`
+
+	rows, err := r.db.Pool.Query(ctx, query, domain.StatusFinished)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query completed tournaments: %w", err)
+	}
+	defer rows.Close()
 
-	if status != "" {
-		query += " AND m.status = $1 ORDER BY m.start_time DESC"
-		rows, err = r.db.Pool.Query(ctx, query, status)
-	} else {
-		query += " ORDER BY m.start_time DESC"
-		rows, err = r.db.Pool.Query(ctx, query)
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan tournament ID: %w", err)
+		}
+		ids = append(ids, id)
 	}
+	return ids, rows.Err()
+}
 
+// queryMatches runs a matchSelect query and loads each match's sets
+func (r *MatchRepository) queryMatches(ctx context.Context, query string, args ...interface{}) ([]*domain.Match, error) {
+	rows, err := r.db.Pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query matches: %w", err)
 	}
@@ -198,35 +385,21 @@ func (r *MatchRepository) GetAll(ctx context.Context, status string) ([]*domain.
 
 	var matches []*domain.Match
 	for rows.Next() {
-		match := &domain.Match{
-			Player1: &domain.Player{},
-			Player2: &domain.Player{},
-		}
-
-		err := rows.Scan(
-			&match.ID, &match.TournamentID, &match.Player1ID, &match.Player2ID,
-			&match.Status, &match.StartTime, &match.WinnerID, &match.IsSimulated,
-			&match.Score.SetsP1, &match.Score.SetsP2,
-			&match.Score.GamesP1, &match.Score.GamesP2,
-			&match.Score.PointsP1, &match.Score.PointsP2,
-			&match.Score.Serving,
-			&match.WinProbP1, &match.LeverageIndex,
-			&match.FatigueP1, &match.FatigueP2,
-			&match.Player1.ID, &match.Player1.Name, &match.Player1.CountryCode, &match.Player1.Rank,
-			&match.Player2.ID, &match.Player2.Name, &match.Player2.CountryCode, &match.Player2.Rank,
-			&match.Stats.AcesP1, &match.Stats.AcesP2,
-			&match.Stats.DoubleFaultsP1, &match.Stats.DoubleFaultsP2,
-			&match.Stats.BreakPointsP1, &match.Stats.BreakPointsP2,
-			&match.Stats.RallyCount,
-		)
+		match, err := scanMatch(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan match: %w", err)
 		}
-
 		matches = append(matches, match)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadSets(ctx, matches...); err != nil {
+		return nil, err
+	}
 
-	return matches, rows.Err()
+	return matches, nil
 }
 
 // DeleteSimulated deletes all simulated matches from the database
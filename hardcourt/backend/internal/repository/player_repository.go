@@ -35,6 +35,27 @@ func (r *PlayerRepository) Create(ctx context.Context, player *domain.Player) er
 	return nil
 }
 
+// Merge inserts a player from a historical dataset, or fills in details
+// an existing player lacks. Name and rank on file are never overwritten,
+// since a dataset's names and rankings are those of its own time.
+func (r *PlayerRepository) Merge(ctx context.Context, player *domain.Player) error {
+	query := `
+		INSERT INTO players (id, name, country_code, rank, height_cm, plays)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), NULLIF($6, ''))
+		ON CONFLICT (id) DO UPDATE SET
+			country_code = CASE WHEN players.country_code = 'XX' THEN EXCLUDED.country_code ELSE players.country_code END,
+			height_cm = COALESCE(players.height_cm, EXCLUDED.height_cm),
+			plays = COALESCE(players.plays, EXCLUDED.plays)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query, player.ID, player.Name, player.CountryCode, player.Rank, player.HeightCm, player.Plays)
+	if err != nil {
+		return fmt.Errorf("failed to merge player: %w", err)
+	}
+
+	return nil
+}
+
 // GetByID retrieves a player by ID
 func (r *PlayerRepository) GetByID(ctx context.Context, id string) (*domain.Player, error) {
 	query := `SELECT id, name, country_code, rank FROM players WHERE id = $1`
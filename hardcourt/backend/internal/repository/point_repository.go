@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"hardcourt/backend/internal/database"
+	"hardcourt/backend/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// PointRepository stores match point logs
+type PointRepository struct {
+	db *database.DB
+}
+
+func NewPointRepository(db *database.DB) *PointRepository {
+	return &PointRepository{db: db}
+}
+
+// Replace swaps a match's point log for the given points in one transaction,
+// so importing the same match again leaves one copy
+func (r *PointRepository) Replace(ctx context.Context, matchID string, points []domain.Point) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin point log transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	batch := &pgx.Batch{}
+	batch.Queue(`DELETE FROM match_points WHERE match_id = $1`, matchID)
+	for _, p := range points {
+		batch.Queue(`
+			INSERT INTO match_points (
+				match_id, point_number, set_number, game_number, server, winner,
+				sets_p1, sets_p2, games_p1, games_p2, points_p1, points_p2,
+				tiebreak, break_point, second_serve, outcome, rally_length, shots, source
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15,
+				NULLIF($16, ''), NULLIF($17, 0), NULLIF($18, ''), NULLIF($19, ''))`,
+			matchID, p.Number, p.SetNumber, p.GameNumber, p.Server, p.Winner,
+			p.Score.SetsP1, p.Score.SetsP2, p.Score.GamesP1, p.Score.GamesP2, p.Score.PointsP1, p.Score.PointsP2,
+			p.Tiebreak, p.BreakPoint, p.SecondServe, string(p.Outcome), p.RallyLength, p.Shots, p.Source,
+		)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to save point log: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit point log: %w", err)
+	}
+	return nil
+}
+
+// GetByMatch returns a match's point log in order
+func (r *PointRepository) GetByMatch(ctx context.Context, matchID string) ([]domain.Point, error) {
+	query := `
+		SELECT match_id, point_number, set_number, game_number, server, winner,
+			sets_p1, sets_p2, games_p1, games_p2, points_p1, points_p2,
+			tiebreak, break_point, second_serve, COALESCE(outcome, ''), COALESCE(rally_length, 0),
+			COALESCE(shots, ''), COALESCE(source, '')
+		FROM match_points
+		WHERE match_id = $1
+		ORDER BY point_number
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get point log: %w", err)
+	}
+	defer rows.Close()
+
+	points := []domain.Point{}
+	for rows.Next() {
+		var p domain.Point
+		var outcome string
+		if err := rows.Scan(
+			&p.MatchID, &p.Number, &p.SetNumber, &p.GameNumber, &p.Server, &p.Winner,
+			&p.Score.SetsP1, &p.Score.SetsP2, &p.Score.GamesP1, &p.Score.GamesP2, &p.Score.PointsP1, &p.Score.PointsP2,
+			&p.Tiebreak, &p.BreakPoint, &p.SecondServe, &outcome, &p.RallyLength, &p.Shots, &p.Source,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan point: %w", err)
+		}
+		p.Outcome = domain.PointOutcome(outcome)
+		p.Score.Serving = p.Server
+		points = append(points, p)
+	}
+	return points, rows.Err()
+}
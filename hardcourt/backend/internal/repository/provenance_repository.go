@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hardcourt/backend/internal/database"
+	"hardcourt/backend/internal/domain"
+)
+
+type ProvenanceRepository struct {
+	db *database.DB
+}
+
+func NewProvenanceRepository(db *database.DB) *ProvenanceRepository {
+	return &ProvenanceRepository{db: db}
+}
+
+// Save replaces the provenance of the given match fields
+func (r *ProvenanceRepository) Save(ctx context.Context, matchID string, provenance []domain.FieldProvenance) error {
+	if len(provenance) == 0 {
+		return nil
+	}
+
+	fields := make([]string, len(provenance))
+	sources := make([]string, len(provenance))
+	observedAt := make([]time.Time, len(provenance))
+	for i, p := range provenance {
+		fields[i], sources[i], observedAt[i] = p.Field, p.Source, p.ObservedAt
+	}
+
+	query := `
+		INSERT INTO match_provenance (match_id, field, source, observed_at)
+		SELECT $1, f.field, f.source, f.observed_at
+		FROM unnest($2::text[], $3::text[], $4::timestamptz[]) AS f(field, source, observed_at)
+		ON CONFLICT (match_id, field) DO UPDATE SET
+			source = EXCLUDED.source,
+			observed_at = EXCLUDED.observed_at
+	`
+
+	if _, err := r.db.Pool.Exec(ctx, query, matchID, fields, sources, observedAt); err != nil {
+		return fmt.Errorf("failed to save provenance: %w", err)
+	}
+	return nil
+}
+
+// GetByMatch returns the provenance of a match's fields
+func (r *ProvenanceRepository) GetByMatch(ctx context.Context, matchID string) ([]domain.FieldProvenance, error) {
+	query := `
+		SELECT field, source, observed_at
+		FROM match_provenance
+		WHERE match_id = $1
+		ORDER BY field
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query provenance: %w", err)
+	}
+	defer rows.Close()
+
+	provenance := []domain.FieldProvenance{}
+	for rows.Next() {
+		var p domain.FieldProvenance
+		if err := rows.Scan(&p.Field, &p.Source, &p.ObservedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan provenance: %w", err)
+		}
+		provenance = append(provenance, p)
+	}
+	return provenance, rows.Err()
+}
@@ -6,6 +6,8 @@ import (
 
 	"hardcourt/backend/internal/database"
 	"hardcourt/backend/internal/domain"
+
+	"github.com/jackc/pgx/v5"
 )
 
 type TournamentDrawRepository struct {
@@ -20,14 +22,14 @@ func NewTournamentDrawRepository(db *database.DB) *TournamentDrawRepository {
 func (r *TournamentDrawRepository) Create(ctx context.Context, draw *domain.TournamentDraw) error {
 	query := `
 		INSERT INTO tournament_draws (
-			tournament_id, round, position, player_id, seed, bye
-		) VALUES ($1, $2, $3, $4, $5, $6)
+			tournament_id, round, position, player_id, seed, bye, entry_type
+		) VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''))
 		ON CONFLICT (tournament_id, round, position) DO NOTHING
 	`
 
 	_, err := r.db.Pool.Exec(ctx, query,
 		draw.TournamentID, draw.Round, draw.Position,
-		draw.PlayerID, draw.Seed, draw.Bye,
+		draw.PlayerID, draw.Seed, draw.Bye, draw.EntryType,
 	)
 
 	if err != nil {
@@ -37,14 +39,47 @@ func (r *TournamentDrawRepository) Create(ctx context.Context, draw *domain.Tour
 	return nil
 }
 
-// GetByTournament retrieves all draw entries for a tournament
+// Replace swaps a tournament's whole draw for a new one, e.g. a freshly
+// generated first round, in one transaction
+func (r *TournamentDrawRepository) Replace(ctx context.Context, tournamentID string, draws []*domain.TournamentDraw) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin draw transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	batch := &pgx.Batch{}
+	batch.Queue(`DELETE FROM tournament_draws WHERE tournament_id = $1`, tournamentID)
+	for _, draw := range draws {
+		batch.Queue(`
+			INSERT INTO tournament_draws (
+				tournament_id, round, position, player_id, seed, bye, entry_type
+			) VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''))`,
+			tournamentID, draw.Round, draw.Position,
+			draw.PlayerID, draw.Seed, draw.Bye, draw.EntryType,
+		)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to save tournament draw: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit tournament draw: %w", err)
+	}
+	return nil
+}
+
+// GetByTournament retrieves all draw entries for a tournament, with the
+// player of each entry that has one
 func (r *TournamentDrawRepository) GetByTournament(ctx context.Context, tournamentID string) ([]*domain.TournamentDraw, error) {
 	query := `
 		SELECT
-			id, tournament_id, round, position, player_id, seed, bye
-		FROM tournament_draws
-		WHERE tournament_id = $1
-		ORDER BY round, position
+			d.id, d.tournament_id, d.round, d.position, d.player_id, d.seed, d.bye, COALESCE(d.entry_type, ''),
+			COALESCE(p.name, ''), COALESCE(p.country_code, ''), COALESCE(p.rank, 0)
+		FROM tournament_draws d
+		LEFT JOIN players p ON p.id = d.player_id
+		WHERE d.tournament_id = $1
+		ORDER BY d.round, d.position
 	`
 
 	rows, err := r.db.Pool.Query(ctx, query, tournamentID)
@@ -56,14 +91,20 @@ func (r *TournamentDrawRepository) GetByTournament(ctx context.Context, tourname
 	var draws []*domain.TournamentDraw
 	for rows.Next() {
 		draw := &domain.TournamentDraw{}
+		player := &domain.Player{}
 
 		err := rows.Scan(
 			&draw.ID, &draw.TournamentID, &draw.Round,
-			&draw.Position, &draw.PlayerID, &draw.Seed, &draw.Bye,
+			&draw.Position, &draw.PlayerID, &draw.Seed, &draw.Bye, &draw.EntryType,
+			&player.Name, &player.CountryCode, &player.Rank,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan tournament draw: %w", err)
 		}
+		if draw.PlayerID != nil {
+			player.ID = *draw.PlayerID
+			draw.Player = player
+		}
 
 		draws = append(draws, draw)
 	}
@@ -75,7 +116,7 @@ func (r *TournamentDrawRepository) GetByTournament(ctx context.Context, tourname
 func (r *TournamentDrawRepository) GetByRound(ctx context.Context, tournamentID, round string) ([]*domain.TournamentDraw, error) {
 	query := `
 		SELECT
-			id, tournament_id, round, position, player_id, seed, bye
+			id, tournament_id, round, position, player_id, seed, bye, COALESCE(entry_type, '')
 		FROM tournament_draws
 		WHERE tournament_id = $1 AND round = $2
 		ORDER BY position
@@ -93,7 +134,7 @@ func (r *TournamentDrawRepository) GetByRound(ctx context.Context, tournamentID,
 
 		err := rows.Scan(
 			&draw.ID, &draw.TournamentID, &draw.Round,
-			&draw.Position, &draw.PlayerID, &draw.Seed, &draw.Bye,
+			&draw.Position, &draw.PlayerID, &draw.Seed, &draw.Bye, &draw.EntryType,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan tournament draw: %w", err)
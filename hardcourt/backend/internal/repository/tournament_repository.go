@@ -35,13 +35,66 @@ func (r *TournamentRepository) Create(ctx context.Context, tournament *domain.To
 	return nil
 }
 
+// Upsert inserts or merges a tournament from a data feed. Empty fields and
+// placeholders never overwrite what is already stored, so a sparse feed
+// record cannot degrade a seeded tournament.
+func (r *TournamentRepository) Upsert(ctx context.Context, tournament *domain.Tournament) error {
+	name := tournament.Name
+	if name == tournament.ID {
+		name = ""
+	}
+	city := tournament.City
+	if city == "Unknown" {
+		city = ""
+	}
+
+	query := `
+		INSERT INTO tournaments (id, name, surface, city, country, category, year, source, external_id, season_id,
+			start_date, end_date)
+		VALUES ($1, COALESCE(NULLIF($2, ''), $1), $3, $4, NULLIF($5, ''), NULLIF($6, ''), NULLIF($7, 0),
+			NULLIF($8, ''), NULLIF($9, ''), NULLIF($10, ''), $11, $12)
+		ON CONFLICT (id) DO UPDATE SET
+			name = COALESCE(NULLIF($2, ''), tournaments.name),
+			surface = COALESCE(NULLIF(EXCLUDED.surface, ''), tournaments.surface),
+			city = COALESCE(NULLIF(EXCLUDED.city, ''), tournaments.city),
+			country = COALESCE(EXCLUDED.country, tournaments.country),
+			category = COALESCE(EXCLUDED.category, tournaments.category),
+			year = COALESCE(EXCLUDED.year, tournaments.year),
+			source = COALESCE(EXCLUDED.source, tournaments.source),
+			external_id = COALESCE(EXCLUDED.external_id, tournaments.external_id),
+			season_id = COALESCE(EXCLUDED.season_id, tournaments.season_id),
+			start_date = COALESCE(EXCLUDED.start_date, tournaments.start_date),
+			end_date = COALESCE(EXCLUDED.end_date, tournaments.end_date),
+			updated_at = NOW()
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		tournament.ID, name, tournament.Surface, city, tournament.Country,
+		tournament.Category, tournament.Year,
+		tournament.Source, tournament.ExternalID, tournament.SeasonID,
+		tournament.StartDate, tournament.EndDate,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert tournament: %w", err)
+	}
+
+	return nil
+}
+
 // GetByID retrieves a tournament by ID
 func (r *TournamentRepository) GetByID(ctx context.Context, id string) (*domain.Tournament, error) {
-	query := `SELECT id, name, surface, city FROM tournaments WHERE id = $1`
+	query := `
+		SELECT id, name, surface, city, COALESCE(country, ''), COALESCE(category, ''), COALESCE(year, 0),
+			COALESCE(source, ''), COALESCE(external_id, ''), COALESCE(season_id, ''), start_date, end_date
+		FROM tournaments WHERE id = $1
+	`
 
 	tournament := &domain.Tournament{}
 	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
 		&tournament.ID, &tournament.Name, &tournament.Surface, &tournament.City,
+		&tournament.Country, &tournament.Category, &tournament.Year,
+		&tournament.Source, &tournament.ExternalID, &tournament.SeasonID,
+		&tournament.StartDate, &tournament.EndDate,
 	)
 
 	if err != nil {
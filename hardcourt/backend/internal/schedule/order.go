@@ -0,0 +1,98 @@
+// Package schedule orders scheduled matches court by court and groups them
+// into a tournament's order of play.
+package schedule
+
+import (
+	"sort"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// UnassignedCourt groups matches whose court is not yet known
+const UnassignedCourt = "Court TBA"
+
+// DayBreak is the gap between consecutive starts on a court that separates
+// two days of play. Starts within a day, night sessions included, are closer.
+const DayBreak = 8 * time.Hour
+
+// Order fills in CourtOrder when a feed gives only courts and start times:
+// each court's matches are numbered by start time, starting again after a
+// DayBreak. Matches that already have an order, or no court, are left alone.
+func Order(matches []*domain.Match) {
+	byCourt := make(map[string][]*domain.Match)
+	for _, m := range matches {
+		if m.Court != "" {
+			byCourt[m.Court] = append(byCourt[m.Court], m)
+		}
+	}
+
+	for _, court := range byCourt {
+		sortByStart(court)
+		slot := 0
+		for i, m := range court {
+			if i > 0 && m.StartTime.Sub(court[i-1].StartTime) >= DayBreak {
+				slot = 0
+			}
+			slot++
+			if m.CourtOrder == 0 {
+				m.CourtOrder = slot
+			}
+		}
+	}
+}
+
+// Group arranges matches into days and courts. Days run in date order,
+// courts by their first start, and matches by CourtOrder then start time.
+func Group(matches []*domain.Match, loc *time.Location) []domain.OrderOfPlayDay {
+	days := make(map[string]map[string][]*domain.Match)
+	for _, m := range matches {
+		day := m.StartTime.In(loc).Format("2006-01-02")
+		court := m.Court
+		if court == "" {
+			court = UnassignedCourt
+		}
+		if days[day] == nil {
+			days[day] = make(map[string][]*domain.Match)
+		}
+		days[day][court] = append(days[day][court], m)
+	}
+
+	result := make([]domain.OrderOfPlayDay, 0, len(days))
+	for date, courts := range days {
+		day := domain.OrderOfPlayDay{Date: date}
+		for court, courtMatches := range courts {
+			sortByStart(courtMatches)
+			day.Courts = append(day.Courts, domain.CourtSchedule{Court: court, Matches: courtMatches})
+		}
+		sort.Slice(day.Courts, func(i, j int) bool {
+			a, b := day.Courts[i], day.Courts[j]
+			// Unassigned matches go last
+			if (a.Court == UnassignedCourt) != (b.Court == UnassignedCourt) {
+				return b.Court == UnassignedCourt
+			}
+			if !a.Matches[0].StartTime.Equal(b.Matches[0].StartTime) {
+				return a.Matches[0].StartTime.Before(b.Matches[0].StartTime)
+			}
+			return a.Court < b.Court
+		})
+		result = append(result, day)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Date < result[j].Date })
+	return result
+}
+
+// sortByStart orders one court's matches by their known order, then start
+// time, then ID so the result is stable across polls
+func sortByStart(matches []*domain.Match) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.CourtOrder != 0 && b.CourtOrder != 0 && a.CourtOrder != b.CourtOrder {
+			return a.CourtOrder < b.CourtOrder
+		}
+		if !a.StartTime.Equal(b.StartTime) {
+			return a.StartTime.Before(b.StartTime)
+		}
+		return a.ID < b.ID
+	})
+}
@@ -0,0 +1,63 @@
+package schedule
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+func TestOrder(t *testing.T) {
+	day := time.Date(2025, 1, 25, 0, 0, 0, 0, time.UTC) // 11:00 in Melbourne
+	matches := []*domain.Match{
+		{ID: "night", Court: "Rod Laver Arena", StartTime: day.Add(8 * time.Hour)},
+		{ID: "first", Court: "Rod Laver Arena", StartTime: day},
+		{ID: "second", Court: "Rod Laver Arena", StartTime: day.Add(2 * time.Hour)},
+		{ID: "mca", Court: "Margaret Court Arena", StartTime: day.Add(time.Hour)},
+		{ID: "tomorrow", Court: "Rod Laver Arena", StartTime: day.Add(24 * time.Hour)},
+		{ID: "unknown", StartTime: day},
+	}
+	Order(matches)
+
+	want := map[string]int{"first": 1, "second": 2, "night": 3, "mca": 1, "tomorrow": 1, "unknown": 0}
+	for _, m := range matches {
+		if m.CourtOrder != want[m.ID] {
+			t.Errorf("%s: CourtOrder = %d, want %d", m.ID, m.CourtOrder, want[m.ID])
+		}
+	}
+}
+
+func TestGroup(t *testing.T) {
+	melbourne := time.FixedZone("AEDT", 11*60*60)
+	day := time.Date(2025, 1, 25, 11, 0, 0, 0, melbourne)
+	matches := []*domain.Match{
+		{ID: "tba", StartTime: day},
+		{ID: "rla-2", Court: "Rod Laver Arena", CourtOrder: 2, NotBefore: "19:00", StartTime: day.Add(8 * time.Hour)},
+		{ID: "mca-1", Court: "Margaret Court Arena", CourtOrder: 1, StartTime: day.Add(time.Hour)},
+		{ID: "rla-1", Court: "Rod Laver Arena", CourtOrder: 1, StartTime: day},
+		{ID: "next-day", Court: "Rod Laver Arena", CourtOrder: 1, StartTime: day.Add(24 * time.Hour)},
+	}
+
+	days := Group(matches, melbourne)
+	if len(days) != 2 || days[0].Date != "2025-01-25" || days[1].Date != "2025-01-26" {
+		t.Fatalf("Expected two days in order, got %+v", days)
+	}
+
+	var got [][]string
+	for _, court := range days[0].Courts {
+		ids := []string{court.Court}
+		for _, m := range court.Matches {
+			ids = append(ids, m.ID)
+		}
+		got = append(got, ids)
+	}
+	want := [][]string{
+		{"Rod Laver Arena", "rla-1", "rla-2"},
+		{"Margaret Court Arena", "mca-1"},
+		{UnassignedCourt, "tba"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Courts = %v, want %v", got, want)
+	}
+}
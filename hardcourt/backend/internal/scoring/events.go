@@ -0,0 +1,195 @@
+package scoring
+
+import (
+	"sync"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// Detect compares two states of the same match and returns the typed events
+// that explain the transition. Feeds that are polled can skip several points
+// between states; in that case only the net change is reported.
+func Detect(prev, next *domain.Match, at time.Time) []domain.MatchEvent {
+	if prev == nil || next == nil {
+		return nil
+	}
+
+	var events []domain.MatchEvent
+	emit := func(ev domain.MatchEvent) {
+		ev.MatchID = next.ID
+		ev.TournamentID = next.TournamentID
+		ev.Score = next.Score
+		ev.Timestamp = at
+		events = append(events, ev)
+	}
+
+	ps, ns := prev.Score, next.Score
+	setsChanged := ns.SetsP1 != ps.SetsP1 || ns.SetsP2 != ps.SetsP2
+	gamesChanged := setsChanged || ns.GamesP1 != ps.GamesP1 || ns.GamesP2 != ps.GamesP2
+	pointsChanged := ns.PointsP1 != ps.PointsP1 || ns.PointsP2 != ps.PointsP2
+
+	if gamesChanged || pointsChanged {
+		var winner int
+		switch {
+		case setsChanged:
+			winner = increased(ps.SetsP1, ns.SetsP1, ps.SetsP2, ns.SetsP2)
+		case gamesChanged:
+			winner = increased(ps.GamesP1, ns.GamesP1, ps.GamesP2, ns.GamesP2)
+		default:
+			winner = pointWinner(ps, ns)
+		}
+
+		if winner != 0 {
+			emit(domain.MatchEvent{Type: domain.EventPointWon, Player: winner, SetNumber: currentSet(ps)})
+
+			loser := opponent(winner)
+			if IsMatchPoint(ps, loser, SetsToWin(prev)) && next.Status != domain.StatusFinished {
+				emit(domain.MatchEvent{Type: domain.EventMatchPointSaved, Player: winner, SetNumber: currentSet(ps)})
+			}
+
+			if gamesChanged {
+				emit(domain.MatchEvent{Type: domain.EventGameWon, Player: winner, SetNumber: currentSet(ps)})
+				if !InTiebreak(ps) && ps.Serving != 0 && ps.Serving != winner {
+					emit(domain.MatchEvent{Type: domain.EventBreakOfServe, Player: winner, SetNumber: currentSet(ps)})
+				}
+			}
+
+			if setsChanged {
+				emit(domain.MatchEvent{
+					Type:      domain.EventSetWon,
+					Player:    winner,
+					SetNumber: currentSet(ps),
+					Set:       finishedSet(prev, next, winner),
+				})
+			}
+		}
+
+		if InTiebreak(ns) && (!InTiebreak(ps) || setsChanged) {
+			emit(domain.MatchEvent{Type: domain.EventTiebreakStarted, SetNumber: currentSet(ns)})
+		}
+	}
+
+	if prev.Status != next.Status {
+		emit(domain.MatchEvent{Type: domain.EventStatusChanged, FromStatus: prev.Status, ToStatus: next.Status})
+		if next.Status == domain.StatusFinished {
+			emit(domain.MatchEvent{Type: domain.EventMatchFinished, Player: matchWinner(next)})
+		}
+	}
+
+	return events
+}
+
+// increased returns the player whose counter went up, or 0 if it is ambiguous
+func increased(before1, after1, before2, after2 int) int {
+	up1, up2 := after1 > before1, after2 > before2
+	switch {
+	case up1 && !up2:
+		return 1
+	case up2 && !up1:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// pointWinner works out who won the point when the game did not change
+func pointWinner(ps, ns domain.ScoreState) int {
+	tb := InTiebreak(ps)
+	b1, a1 := PointValue(ps.PointsP1, tb), PointValue(ns.PointsP1, tb)
+	b2, a2 := PointValue(ps.PointsP2, tb), PointValue(ns.PointsP2, tb)
+
+	// Advantage lost: back to deuce means the other player won the point
+	if !tb && b1 == 4 && a1 == 3 && a2 == 3 {
+		return 2
+	}
+	if !tb && b2 == 4 && a2 == 3 && a1 == 3 {
+		return 1
+	}
+	return increased(b1, a1, b2, a2)
+}
+
+// currentSet returns the number of the set being played in s
+func currentSet(s domain.ScoreState) int {
+	return s.SetsP1 + s.SetsP2 + 1
+}
+
+// finishedSet returns the score of the set that was just completed
+func finishedSet(prev, next *domain.Match, winner int) *domain.SetScore {
+	number := currentSet(prev.Score)
+	for i := range next.Sets {
+		if next.Sets[i].SetNumber == number {
+			set := next.Sets[i]
+			return &set
+		}
+	}
+
+	set := &domain.SetScore{SetNumber: number, GamesP1: prev.Score.GamesP1, GamesP2: prev.Score.GamesP2}
+	if winner == 1 {
+		set.GamesP1++
+	} else {
+		set.GamesP2++
+	}
+	return set
+}
+
+// matchWinner returns 1 or 2 for the winner of a finished match, 0 if unknown
+func matchWinner(m *domain.Match) int {
+	if m.WinnerID != nil {
+		switch *m.WinnerID {
+		case m.Player1ID:
+			return 1
+		case m.Player2ID:
+			return 2
+		}
+	}
+	switch {
+	case m.Score.SetsP1 > m.Score.SetsP2:
+		return 1
+	case m.Score.SetsP2 > m.Score.SetsP1:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// Tracker remembers the last state of every match it has seen so that
+// producers which only know the current state (polled feeds) can emit events.
+type Tracker struct {
+	mu   sync.Mutex
+	last map[string]domain.Match
+}
+
+// NewTracker creates an empty tracker
+func NewTracker() *Tracker {
+	return &Tracker{last: make(map[string]domain.Match)}
+}
+
+// Observe records the new state of a match and returns the events since the
+// previous observation. The first observation of a match yields no events.
+func (t *Tracker) Observe(m *domain.Match) []domain.MatchEvent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	snapshot := Snapshot(m)
+	prev, seen := t.last[m.ID]
+	t.last[m.ID] = snapshot
+	if !seen {
+		return nil
+	}
+	return Detect(&prev, &snapshot, time.Now())
+}
+
+// Forget drops the remembered state of a match
+func (t *Tracker) Forget(matchID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.last, matchID)
+}
+
+// Snapshot returns a copy of the match that does not share the sets slice
+func Snapshot(m *domain.Match) domain.Match {
+	snapshot := *m
+	snapshot.Sets = append([]domain.SetScore(nil), m.Sets...)
+	return snapshot
+}
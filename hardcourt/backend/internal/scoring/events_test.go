@@ -0,0 +1,145 @@
+package scoring
+
+import (
+	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+func liveMatch(score domain.ScoreState) *domain.Match {
+	return &domain.Match{
+		ID:           "m1",
+		TournamentID: "t1",
+		Player1ID:    "p1",
+		Player2ID:    "p2",
+		Status:       domain.StatusLive,
+		Score:        score,
+	}
+}
+
+func eventTypes(events []domain.MatchEvent) []domain.MatchEventType {
+	types := make([]domain.MatchEventType, len(events))
+	for i, ev := range events {
+		types[i] = ev.Type
+	}
+	return types
+}
+
+func assertTypes(t *testing.T, events []domain.MatchEvent, want ...domain.MatchEventType) {
+	t.Helper()
+	got := eventTypes(events)
+	if len(got) != len(want) {
+		t.Fatalf("Expected events %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected events %v, got %v", want, got)
+		}
+	}
+}
+
+func TestDetect_PointWon(t *testing.T) {
+	prev := liveMatch(domain.ScoreState{PointsP1: "15", PointsP2: "0", Serving: 1})
+	next := liveMatch(domain.ScoreState{PointsP1: "15", PointsP2: "15", Serving: 1})
+
+	events := Detect(prev, next, time.Now())
+	assertTypes(t, events, domain.EventPointWon)
+
+	if events[0].Player != 2 {
+		t.Errorf("Expected point won by player 2, got %d", events[0].Player)
+	}
+	if events[0].MatchID != "m1" || events[0].TournamentID != "t1" {
+		t.Errorf("Expected event for m1/t1, got %s/%s", events[0].MatchID, events[0].TournamentID)
+	}
+}
+
+func TestDetect_AdvantageLost(t *testing.T) {
+	prev := liveMatch(domain.ScoreState{PointsP1: "AD", PointsP2: "40", Serving: 1})
+	next := liveMatch(domain.ScoreState{PointsP1: "40", PointsP2: "40", Serving: 1})
+
+	events := Detect(prev, next, time.Now())
+	assertTypes(t, events, domain.EventPointWon)
+
+	if events[0].Player != 2 {
+		t.Errorf("Expected point won by player 2, got %d", events[0].Player)
+	}
+}
+
+func TestDetect_BreakOfServe(t *testing.T) {
+	prev := liveMatch(domain.ScoreState{GamesP1: 2, GamesP2: 2, PointsP1: "30", PointsP2: "40", Serving: 1})
+	next := liveMatch(domain.ScoreState{GamesP1: 2, GamesP2: 3, PointsP1: "0", PointsP2: "0", Serving: 2})
+
+	events := Detect(prev, next, time.Now())
+	assertTypes(t, events, domain.EventPointWon, domain.EventGameWon, domain.EventBreakOfServe)
+
+	if events[2].Player != 2 {
+		t.Errorf("Expected break by player 2, got %d", events[2].Player)
+	}
+}
+
+func TestDetect_SetWonAndTiebreak(t *testing.T) {
+	prev := liveMatch(domain.ScoreState{GamesP1: 6, GamesP2: 5, PointsP1: "0", PointsP2: "40", Serving: 1})
+	next := liveMatch(domain.ScoreState{GamesP1: 6, GamesP2: 6, PointsP1: "0", PointsP2: "0", Serving: 2})
+
+	events := Detect(prev, next, time.Now())
+	assertTypes(t, events, domain.EventPointWon, domain.EventGameWon, domain.EventBreakOfServe, domain.EventTiebreakStarted)
+
+	prev = liveMatch(domain.ScoreState{GamesP1: 6, GamesP2: 6, PointsP1: "6", PointsP2: "4", Serving: 1})
+	next = liveMatch(domain.ScoreState{SetsP1: 1, PointsP1: "0", PointsP2: "0", Serving: 2})
+	next.Sets = []domain.SetScore{{SetNumber: 1, GamesP1: 7, GamesP2: 6, TiebreakP1: 7, TiebreakP2: 4}}
+
+	events = Detect(prev, next, time.Now())
+	assertTypes(t, events, domain.EventPointWon, domain.EventGameWon, domain.EventSetWon)
+
+	set := events[2].Set
+	if set == nil || set.GamesP1 != 7 || set.GamesP2 != 6 || set.TiebreakP1 != 7 {
+		t.Errorf("Expected set 7-6(4), got %+v", set)
+	}
+}
+
+func TestDetect_MatchPointSavedAndFinished(t *testing.T) {
+	prev := liveMatch(domain.ScoreState{SetsP1: 1, GamesP1: 5, GamesP2: 3, PointsP1: "40", PointsP2: "30", Serving: 1})
+	next := liveMatch(domain.ScoreState{SetsP1: 1, GamesP1: 5, GamesP2: 3, PointsP1: "40", PointsP2: "40", Serving: 1})
+
+	events := Detect(prev, next, time.Now())
+	assertTypes(t, events, domain.EventPointWon, domain.EventMatchPointSaved)
+
+	if events[1].Player != 2 {
+		t.Errorf("Expected match point saved by player 2, got %d", events[1].Player)
+	}
+
+	prev = liveMatch(domain.ScoreState{SetsP1: 1, GamesP1: 5, GamesP2: 3, PointsP1: "AD", PointsP2: "40", Serving: 1})
+	next = liveMatch(domain.ScoreState{SetsP1: 2, PointsP1: "0", PointsP2: "0", Serving: 2})
+	next.Status = domain.StatusFinished
+	winner := "p1"
+	next.WinnerID = &winner
+
+	events = Detect(prev, next, time.Now())
+	assertTypes(t, events,
+		domain.EventPointWon, domain.EventGameWon, domain.EventSetWon,
+		domain.EventStatusChanged, domain.EventMatchFinished,
+	)
+
+	if events[4].Player != 1 {
+		t.Errorf("Expected match won by player 1, got %d", events[4].Player)
+	}
+}
+
+func TestTracker_FirstObservationIsBaseline(t *testing.T) {
+	tracker := NewTracker()
+	m := liveMatch(domain.ScoreState{PointsP1: "0", PointsP2: "0", Serving: 1})
+
+	if events := tracker.Observe(m); len(events) != 0 {
+		t.Errorf("Expected no events on first observation, got %v", eventTypes(events))
+	}
+
+	m.Score.PointsP1 = "15"
+	if events := tracker.Observe(m); len(events) != 1 {
+		t.Errorf("Expected 1 event after a point, got %v", eventTypes(events))
+	}
+
+	if events := tracker.Observe(m); len(events) != 0 {
+		t.Errorf("Expected no events for an unchanged match, got %v", eventTypes(events))
+	}
+}
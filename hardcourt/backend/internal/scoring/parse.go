@@ -0,0 +1,382 @@
+package scoring
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// Outcome records how a finished match ended when it was not played out
+type Outcome string
+
+const (
+	OutcomeCompleted Outcome = ""
+	OutcomeRetired   Outcome = "RET"
+	OutcomeWalkover  Outcome = "W/O"
+	OutcomeDefault   Outcome = "DEF"
+	OutcomeAbandoned Outcome = "ABD"
+)
+
+// ParsedScore is a score string broken into sets
+type ParsedScore struct {
+	// Sets are the sets played, numbered from 1. A match ended early
+	// (retirement, default) includes the unfinished final set.
+	Sets []domain.SetScore
+	// Score holds sets won plus the games and points of a set in progress
+	Score   domain.ScoreState
+	Status  domain.MatchStatus
+	Outcome Outcome
+}
+
+// Winner returns 1 or 2 for a match won on court, or 0 if the score alone
+// does not say (not finished, or ended by retirement, walkover or default)
+func (p ParsedScore) Winner() int {
+	if p.Status != domain.StatusFinished || p.Outcome != OutcomeCompleted {
+		return 0
+	}
+	switch {
+	case p.Score.SetsP1 > p.Score.SetsP2:
+		return 1
+	case p.Score.SetsP2 > p.Score.SetsP1:
+		return 2
+	}
+	return 0
+}
+
+// ErrInvalidScore is wrapped by every ParseScore error
+var ErrInvalidScore = errors.New("invalid score")
+
+var (
+	setPattern      = regexp.MustCompile(`^(\d{1,2})[-:/](\d{1,2})(?:\((\d{1,2})(?:-(\d{1,2}))?\))?$`)
+	compactPattern  = regexp.MustCompile(`^(\d)(\d)(?:\((\d{1,2})\))?$`)
+	matchTBPattern  = regexp.MustCompile(`^\[(\d{1,2})-(\d{1,2})\]$`)
+	pointsPattern   = regexp.MustCompile(`^\(?([0-9]{1,2}|a|ad)[-:]([0-9]{1,2}|a|ad)\)?$`)
+	tiebreakPattern = regexp.MustCompile(`^\((\d{1,2})(?:-(\d{1,2}))?\)$`)
+)
+
+var outcomes = map[string]Outcome{
+	"ret": OutcomeRetired, "ret.": OutcomeRetired, "retired": OutcomeRetired, "retd": OutcomeRetired, "ret'd": OutcomeRetired,
+	"w/o": OutcomeWalkover, "wo": OutcomeWalkover, "w.o.": OutcomeWalkover, "walkover": OutcomeWalkover,
+	"def": OutcomeDefault, "def.": OutcomeDefault, "default": OutcomeDefault, "disq": OutcomeDefault, "dq": OutcomeDefault,
+	"abd": OutcomeAbandoned, "abn": OutcomeAbandoned, "abandoned": OutcomeAbandoned,
+}
+
+// ParseScore parses the score formats feeds and datasets use, always from
+// player 1's side:
+//
+//	"7-6(4) 6-3"         sets, loser's tiebreak points in brackets
+//	"76(4) 63"           compact sets
+//	"6-4 3-6 [10-8]"     match tiebreak in place of a final set
+//	"6-4 3-6 13-12(3)"   final set tiebreak at 12-12
+//	"6-4 3-6 2-1 RET"    retirement, likewise W/O, DEF and ABD
+//	"6-4 3-2 40-15"      live, with the points of the current game
+//	"6-4 6-6 5-3"        live, in a tiebreak
+//
+// setsToWin decides when the match is over; pass 0 if unknown, in which
+// case a player ahead with at least two sets has won. An empty score is a
+// scheduled match.
+func ParseScore(text string, setsToWin int) (ParsedScore, error) {
+	parsed := ParsedScore{Status: domain.StatusScheduled}
+
+	tokens := tokenize(text)
+	if len(tokens) == 0 {
+		return parsed, nil
+	}
+
+	// Outcome markers close the score
+	if outcome, ok := outcomes[tokens[len(tokens)-1]]; ok {
+		parsed.Outcome = outcome
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	var points []string
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if _, ok := outcomes[token]; ok {
+			return ParsedScore{}, invalid(text, "outcome %q before the end", token)
+		}
+
+		// A set in progress can only be followed by its points
+		if n := len(parsed.Sets); n > 0 && !setComplete(parsed.Sets[n-1]) {
+			if points != nil || i != len(tokens)-1 {
+				return ParsedScore{}, invalid(text, "unfinished set %d is not the last", n)
+			}
+			m := pointsPattern.FindStringSubmatch(token)
+			if m == nil {
+				return ParsedScore{}, invalid(text, "bad points %q", token)
+			}
+			points = []string{m[1], m[2]}
+			continue
+		}
+
+		// "7-6 (4)": tiebreak written apart from its set
+		if m := tiebreakPattern.FindStringSubmatch(token); m != nil && len(parsed.Sets) > 0 {
+			last := &parsed.Sets[len(parsed.Sets)-1]
+			if last.TiebreakP1 != 0 || last.TiebreakP2 != 0 {
+				return ParsedScore{}, invalid(text, "second tiebreak %q for set %d", token, last.SetNumber)
+			}
+			if err := applyTiebreak(last, m[1], m[2]); err != nil {
+				return ParsedScore{}, invalid(text, "%v", err)
+			}
+			continue
+		}
+
+		set, err := parseSet(token)
+		if err != nil {
+			return ParsedScore{}, invalid(text, "%v", err)
+		}
+		set.SetNumber = len(parsed.Sets) + 1
+		parsed.Sets = append(parsed.Sets, set)
+	}
+
+	if err := parsed.settle(points, setsToWin); err != nil {
+		return ParsedScore{}, invalid(text, "%v", err)
+	}
+	return parsed, nil
+}
+
+// settle counts sets and works out the status once every token is read
+func (p *ParsedScore) settle(points []string, setsToWin int) error {
+	var current *domain.SetScore
+	for i := range p.Sets {
+		set := p.Sets[i]
+		if !setComplete(set) {
+			current = &p.Sets[i]
+			break
+		}
+		if setsToWin > 0 && (p.Score.SetsP1 >= setsToWin || p.Score.SetsP2 >= setsToWin) {
+			return fmt.Errorf("set %d played after the match was won", set.SetNumber)
+		}
+		if set.GamesP1 > set.GamesP2 {
+			p.Score.SetsP1++
+		} else {
+			p.Score.SetsP2++
+		}
+	}
+
+	if current != nil {
+		p.Score.GamesP1, p.Score.GamesP2 = current.GamesP1, current.GamesP2
+	}
+	if points != nil {
+		if err := p.setPoints(points); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case p.Outcome != OutcomeCompleted:
+		p.Status = domain.StatusFinished
+	case current != nil:
+		p.Status = domain.StatusLive
+		// The set in progress is not a completed set
+		p.Sets = p.Sets[:len(p.Sets)-1]
+	case matchWon(p.Score.SetsP1, p.Score.SetsP2, setsToWin):
+		p.Status = domain.StatusFinished
+	default:
+		p.Status = domain.StatusLive
+	}
+
+	if p.Status == domain.StatusLive && !ValidScore(p.Score, maxInt(setsToWin, p.Score.SetsP1+1, p.Score.SetsP2+1)) {
+		return fmt.Errorf("impossible live score")
+	}
+	return nil
+}
+
+// setPoints stores the current game's points, numeric in a tiebreak
+func (p *ParsedScore) setPoints(points []string) error {
+	tiebreak := InTiebreak(p.Score)
+	for i, point := range points {
+		switch {
+		case point == "a" || point == "ad":
+			if tiebreak {
+				return fmt.Errorf("advantage in a tiebreak")
+			}
+			points[i] = "AD"
+		case tiebreak:
+			// Tiebreaks count 0, 1, 2, ...
+		case !validPoint(point):
+			return fmt.Errorf("bad game points %q", point)
+		}
+	}
+	p.Score.PointsP1, p.Score.PointsP2 = points[0], points[1]
+	return nil
+}
+
+func matchWon(sets1, sets2, setsToWin int) bool {
+	if setsToWin > 0 {
+		return sets1 == setsToWin || sets2 == setsToWin
+	}
+	return sets1 != sets2 && maxInt(sets1, sets2) >= 2
+}
+
+// parseSet parses one set: "7-6(4)", "76(4)" or a match tiebreak "[10-8]"
+func parseSet(token string) (domain.SetScore, error) {
+	if m := matchTBPattern.FindStringSubmatch(token); m != nil {
+		tb1, _ := strconv.Atoi(m[1])
+		tb2, _ := strconv.Atoi(m[2])
+		if !tiebreakComplete(tb1, tb2, 10) {
+			return domain.SetScore{}, fmt.Errorf("unfinished match tiebreak %q", token)
+		}
+		set := domain.SetScore{TiebreakP1: tb1, TiebreakP2: tb2}
+		if tb1 > tb2 {
+			set.GamesP1 = 1
+		} else {
+			set.GamesP2 = 1
+		}
+		return set, nil
+	}
+
+	m := setPattern.FindStringSubmatch(token)
+	if m == nil {
+		m = compactPattern.FindStringSubmatch(token)
+		if m == nil {
+			return domain.SetScore{}, fmt.Errorf("bad set %q", token)
+		}
+		m = append(m, "")
+	}
+
+	var set domain.SetScore
+	set.GamesP1, _ = strconv.Atoi(m[1])
+	set.GamesP2, _ = strconv.Atoi(m[2])
+	if !validSetGames(set.GamesP1, set.GamesP2) {
+		return domain.SetScore{}, fmt.Errorf("impossible set %q", token)
+	}
+	if m[3] != "" {
+		if err := applyTiebreak(&set, m[3], m[4]); err != nil {
+			return domain.SetScore{}, err
+		}
+	}
+	return set, nil
+}
+
+// applyTiebreak sets a 7-6 or 13-12 set's tiebreak points. A single number is the
+// loser's points, as in "7-6(4)".
+func applyTiebreak(set *domain.SetScore, first, second string) error {
+	if !isTiebreakSet(*set) {
+		return fmt.Errorf("tiebreak on a %d-%d set", set.GamesP1, set.GamesP2)
+	}
+	a, _ := strconv.Atoi(first)
+	if second == "" {
+		winner := maxInt(7, a+2)
+		if set.GamesP1 > set.GamesP2 {
+			set.TiebreakP1, set.TiebreakP2 = winner, a
+		} else {
+			set.TiebreakP1, set.TiebreakP2 = a, winner
+		}
+		return nil
+	}
+
+	b, _ := strconv.Atoi(second)
+	if !tiebreakComplete(a, b, 7) || (a > b) != (set.GamesP1 > set.GamesP2) {
+		return fmt.Errorf("tiebreak %s-%s does not decide a %d-%d set", first, second, set.GamesP1, set.GamesP2)
+	}
+	set.TiebreakP1, set.TiebreakP2 = a, b
+	return nil
+}
+
+// validSetGames accepts finished sets, including long advantage sets, and sets in progress
+func validSetGames(g1, g2 int) bool {
+	hi, lo := maxInt(g1, g2), minInt(g1, g2)
+	if hi <= GamesForTiebreak+1 {
+		return validGames(g1, g2)
+	}
+	// Advantage final sets, e.g. 70-68, or one in progress
+	return hi-lo <= 2 && lo >= GamesForTiebreak-1
+}
+
+// setComplete reports whether a set has a winner
+func setComplete(set domain.SetScore) bool {
+	hi, lo := maxInt(set.GamesP1, set.GamesP2), minInt(set.GamesP1, set.GamesP2)
+	if hi == 1 && lo == 0 && (set.TiebreakP1 != 0 || set.TiebreakP2 != 0) {
+		return true // match tiebreak
+	}
+	switch {
+	case hi == GamesForTiebreak && lo <= GamesForTiebreak-2:
+		return true
+	case hi == GamesForTiebreak+1 && (lo == GamesForTiebreak-1 || lo == GamesForTiebreak):
+		return true
+	case hi > GamesForTiebreak+1 && hi-lo == 2:
+		return true
+	case hi == FinalSetGamesForTiebreak+1 && lo == FinalSetGamesForTiebreak:
+		return true
+	}
+	return false
+}
+
+// FinalSetGamesForTiebreak is the game score at which an advantage final
+// set went to a tiebreak, as at Wimbledon from 2019 to 2021: "13-12(3)"
+const FinalSetGamesForTiebreak = 12
+
+// isTiebreakSet reports whether a set was decided by a tiebreak, at 6-6 or
+// at 12-12 in a final set
+func isTiebreakSet(set domain.SetScore) bool {
+	hi, lo := maxInt(set.GamesP1, set.GamesP2), minInt(set.GamesP1, set.GamesP2)
+	return (hi == GamesForTiebreak+1 && lo == GamesForTiebreak) ||
+		(hi == FinalSetGamesForTiebreak+1 && lo == FinalSetGamesForTiebreak)
+}
+
+func tiebreakComplete(a, b, target int) bool {
+	hi, lo := maxInt(a, b), minInt(a, b)
+	return hi >= target && hi-lo >= 2 && (hi == target || hi-lo == 2)
+}
+
+// tokenize lowercases a score and splits it into set, points and outcome tokens
+func tokenize(text string) []string {
+	text = strings.ToLower(text)
+	text = strings.NewReplacer("–", "-", "—", "-", ",", " ", ";", " ", " ", " ").Replace(text)
+	tokens := strings.Fields(text)
+	for i := 0; i < len(tokens); i++ {
+		// "W / O" and "w/o." spellings
+		if tokens[i] == "w" && i+2 < len(tokens) && tokens[i+1] == "/" && tokens[i+2] == "o" {
+			tokens = append(tokens[:i+1], tokens[i+3:]...)
+			tokens[i] = "w/o"
+		}
+		tokens[i] = strings.TrimSuffix(tokens[i], ".")
+		if tokens[i] == "w/o" || tokens[i] == "w.o" {
+			tokens[i] = "w/o"
+		}
+	}
+	return tokens
+}
+
+// FormatSets writes sets in the "7-6(4) 6-3" form ParseScore reads
+func FormatSets(sets []domain.SetScore) string {
+	parts := make([]string, 0, len(sets))
+	for _, set := range sets {
+		if set.GamesP1+set.GamesP2 == 1 && (set.TiebreakP1 != 0 || set.TiebreakP2 != 0) {
+			parts = append(parts, fmt.Sprintf("[%d-%d]", set.TiebreakP1, set.TiebreakP2))
+			continue
+		}
+		part := fmt.Sprintf("%d-%d", set.GamesP1, set.GamesP2)
+		if set.TiebreakP1 != 0 || set.TiebreakP2 != 0 {
+			part += fmt.Sprintf("(%d)", minInt(set.TiebreakP1, set.TiebreakP2))
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " ")
+}
+
+func invalid(text, format string, args ...interface{}) error {
+	return fmt.Errorf("%w %q: %s", ErrInvalidScore, text, fmt.Sprintf(format, args...))
+}
+
+func maxInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
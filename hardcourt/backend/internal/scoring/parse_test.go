@@ -0,0 +1,207 @@
+package scoring
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"hardcourt/backend/internal/domain"
+)
+
+func TestParseScore(t *testing.T) {
+	tests := []struct {
+		text      string
+		setsToWin int
+		sets      []domain.SetScore
+		score     domain.ScoreState
+		status    domain.MatchStatus
+		outcome   Outcome
+	}{
+		{
+			text: "7-6(4) 6-3", setsToWin: 2,
+			sets:   []domain.SetScore{{SetNumber: 1, GamesP1: 7, GamesP2: 6, TiebreakP1: 7, TiebreakP2: 4}, {SetNumber: 2, GamesP1: 6, GamesP2: 3}},
+			score:  domain.ScoreState{SetsP1: 2},
+			status: domain.StatusFinished,
+		},
+		{
+			text:   "76(4) 63",
+			sets:   []domain.SetScore{{SetNumber: 1, GamesP1: 7, GamesP2: 6, TiebreakP1: 7, TiebreakP2: 4}, {SetNumber: 2, GamesP1: 6, GamesP2: 3}},
+			score:  domain.ScoreState{SetsP1: 2},
+			status: domain.StatusFinished,
+		},
+		{
+			text:   "6-7 (10-12), 6-4, 7-5",
+			sets:   []domain.SetScore{{SetNumber: 1, GamesP1: 6, GamesP2: 7, TiebreakP1: 10, TiebreakP2: 12}, {SetNumber: 2, GamesP1: 6, GamesP2: 4}, {SetNumber: 3, GamesP1: 7, GamesP2: 5}},
+			score:  domain.ScoreState{SetsP1: 2, SetsP2: 1},
+			status: domain.StatusFinished,
+		},
+		{
+			text:    "6-4 3-6 2-1 RET",
+			sets:    []domain.SetScore{{SetNumber: 1, GamesP1: 6, GamesP2: 4}, {SetNumber: 2, GamesP1: 3, GamesP2: 6}, {SetNumber: 3, GamesP1: 2, GamesP2: 1}},
+			score:   domain.ScoreState{SetsP1: 1, SetsP2: 1, GamesP1: 2, GamesP2: 1},
+			status:  domain.StatusFinished,
+			outcome: OutcomeRetired,
+		},
+		{text: "W/O", status: domain.StatusFinished, outcome: OutcomeWalkover},
+		{
+			text: "6-4 3-2 40-15", setsToWin: 2,
+			sets:   []domain.SetScore{{SetNumber: 1, GamesP1: 6, GamesP2: 4}},
+			score:  domain.ScoreState{SetsP1: 1, GamesP1: 3, GamesP2: 2, PointsP1: "40", PointsP2: "15"},
+			status: domain.StatusLive,
+		},
+		{
+			text: "6-4 6-6 (5-3)", setsToWin: 2,
+			sets:   []domain.SetScore{{SetNumber: 1, GamesP1: 6, GamesP2: 4}},
+			score:  domain.ScoreState{SetsP1: 1, GamesP1: 6, GamesP2: 6, PointsP1: "5", PointsP2: "3"},
+			status: domain.StatusLive,
+		},
+		{
+			text: "3-6 6-4 4-4 A-40", setsToWin: 3,
+			sets:   []domain.SetScore{{SetNumber: 1, GamesP1: 3, GamesP2: 6}, {SetNumber: 2, GamesP1: 6, GamesP2: 4}},
+			score:  domain.ScoreState{SetsP1: 1, SetsP2: 1, GamesP1: 4, GamesP2: 4, PointsP1: "AD", PointsP2: "40"},
+			status: domain.StatusLive,
+		},
+		{
+			// Between sets of a best-of-five
+			text: "6-4 6-4", setsToWin: 3,
+			sets:   []domain.SetScore{{SetNumber: 1, GamesP1: 6, GamesP2: 4}, {SetNumber: 2, GamesP1: 6, GamesP2: 4}},
+			score:  domain.ScoreState{SetsP1: 2},
+			status: domain.StatusLive,
+		},
+		{
+			text:   "6-4 3-6 [10-8]",
+			sets:   []domain.SetScore{{SetNumber: 1, GamesP1: 6, GamesP2: 4}, {SetNumber: 2, GamesP1: 3, GamesP2: 6}, {SetNumber: 3, GamesP1: 1, TiebreakP1: 10, TiebreakP2: 8}},
+			score:  domain.ScoreState{SetsP1: 2, SetsP2: 1},
+			status: domain.StatusFinished,
+		},
+		{
+			text: "6-4 3-6 6-7(5) 7-6(5) 70-68", setsToWin: 3,
+			sets: []domain.SetScore{
+				{SetNumber: 1, GamesP1: 6, GamesP2: 4}, {SetNumber: 2, GamesP1: 3, GamesP2: 6},
+				{SetNumber: 3, GamesP1: 6, GamesP2: 7, TiebreakP1: 5, TiebreakP2: 7}, {SetNumber: 4, GamesP1: 7, GamesP2: 6, TiebreakP1: 7, TiebreakP2: 5},
+				{SetNumber: 5, GamesP1: 70, GamesP2: 68},
+			},
+			score:  domain.ScoreState{SetsP1: 3, SetsP2: 2},
+			status: domain.StatusFinished,
+		},
+		{
+			// Wimbledon 2019 final, decided by a tiebreak at 12-12
+			text: "7-6(5) 1-6 7-6(4) 4-6 13-12(3)", setsToWin: 3,
+			sets: []domain.SetScore{
+				{SetNumber: 1, GamesP1: 7, GamesP2: 6, TiebreakP1: 7, TiebreakP2: 5}, {SetNumber: 2, GamesP1: 1, GamesP2: 6},
+				{SetNumber: 3, GamesP1: 7, GamesP2: 6, TiebreakP1: 7, TiebreakP2: 4}, {SetNumber: 4, GamesP1: 4, GamesP2: 6},
+				{SetNumber: 5, GamesP1: 13, GamesP2: 12, TiebreakP1: 7, TiebreakP2: 3},
+			},
+			score:  domain.ScoreState{SetsP1: 3, SetsP2: 2},
+			status: domain.StatusFinished,
+		},
+		{
+			text: "6-4 3-6 6-3 3-6 12-13 (5-7)", setsToWin: 3,
+			sets: []domain.SetScore{
+				{SetNumber: 1, GamesP1: 6, GamesP2: 4}, {SetNumber: 2, GamesP1: 3, GamesP2: 6},
+				{SetNumber: 3, GamesP1: 6, GamesP2: 3}, {SetNumber: 4, GamesP1: 3, GamesP2: 6},
+				{SetNumber: 5, GamesP1: 12, GamesP2: 13, TiebreakP1: 5, TiebreakP2: 7},
+			},
+			score:  domain.ScoreState{SetsP1: 2, SetsP2: 3},
+			status: domain.StatusFinished,
+		},
+		{text: "  ", status: domain.StatusScheduled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseScore(tt.text, tt.setsToWin)
+			if err != nil {
+				t.Fatalf("ParseScore(%q) failed: %v", tt.text, err)
+			}
+			if !reflect.DeepEqual(got.Sets, tt.sets) {
+				t.Errorf("Sets = %+v, want %+v", got.Sets, tt.sets)
+			}
+			if got.Score != tt.score {
+				t.Errorf("Score = %+v, want %+v", got.Score, tt.score)
+			}
+			if got.Status != tt.status || got.Outcome != tt.outcome {
+				t.Errorf("Status = %s %q, want %s %q", got.Status, got.Outcome, tt.status, tt.outcome)
+			}
+		})
+	}
+}
+
+func TestParseScore_Invalid(t *testing.T) {
+	for _, text := range []string{
+		"6-4 8-3",          // impossible set
+		"6-4 3-2 6-1",      // set after an unfinished one
+		"6-3 (4)",          // tiebreak on a 6-3 set
+		"6-4 3-6 12-11(5)", // tiebreak before 12-12
+		"6-4 3-6 14-12(5)", // tiebreak on an advantage set
+		"13-12(3-7)",       // tiebreak won by the loser of the set
+		"7-6(9-5)",         // tiebreak that would have ended earlier
+		"6-4 3-2 45-15",    // bad points
+		"6-4 6-4 6-4 6-4",  // set after the match was won
+		"RET 6-4",          // outcome in the middle
+		"six love six love",
+	} {
+		if _, err := ParseScore(text, 3); !errors.Is(err, ErrInvalidScore) {
+			t.Errorf("Expected ParseScore(%q) to fail with ErrInvalidScore, got %v", text, err)
+		}
+	}
+}
+
+func TestParsedScore_Winner(t *testing.T) {
+	finished, _ := ParseScore("6-7(2) 6-7(4) 2-6", 0)
+	if finished.Winner() != 2 {
+		t.Errorf("Expected player 2 to win, got %d", finished.Winner())
+	}
+	retired, _ := ParseScore("6-4 2-1 RET", 0)
+	if retired.Winner() != 0 {
+		t.Errorf("Expected no winner from a retirement score, got %d", retired.Winner())
+	}
+}
+
+func FuzzParseScore(f *testing.F) {
+	for _, seed := range []string{
+		"7-6(4) 6-3", "76(4) 63", "6-4 3-6 2-1 RET", "W/O", "6-4 3-2 40-15",
+		"6-4 6-6 5-3", "6-4 3-6 [10-8]", "6-7 (10-12), 6-4, 7-5", "70-68", "",
+	} {
+		f.Add(seed, 0)
+		f.Add(seed, 3)
+	}
+
+	f.Fuzz(func(t *testing.T, text string, setsToWin int) {
+		if setsToWin < 0 || setsToWin > 5 {
+			return
+		}
+		parsed, err := ParseScore(text, setsToWin)
+		if err != nil {
+			if !errors.Is(err, ErrInvalidScore) {
+				t.Fatalf("Unexpected error type: %v", err)
+			}
+			return
+		}
+
+		switch parsed.Status {
+		case domain.StatusScheduled, domain.StatusLive, domain.StatusFinished:
+		default:
+			t.Fatalf("Unknown status %q", parsed.Status)
+		}
+		if parsed.Score.SetsP1+parsed.Score.SetsP2 > len(parsed.Sets) {
+			t.Fatalf("%q: %d sets won from %d sets", text, parsed.Score.SetsP1+parsed.Score.SetsP2, len(parsed.Sets))
+		}
+		for i, set := range parsed.Sets {
+			if set.SetNumber != i+1 {
+				t.Fatalf("%q: set %d numbered %d", text, i+1, set.SetNumber)
+			}
+		}
+
+		// Completed matches survive a round trip through FormatSets
+		if parsed.Status == domain.StatusFinished && parsed.Outcome == OutcomeCompleted {
+			again, err := ParseScore(FormatSets(parsed.Sets), setsToWin)
+			if err != nil {
+				t.Fatalf("%q formatted as %q does not parse: %v", text, FormatSets(parsed.Sets), err)
+			}
+			if !reflect.DeepEqual(again.Sets, parsed.Sets) || again.Score != parsed.Score {
+				t.Fatalf("%q round trip changed the score: %+v vs %+v", text, again, parsed)
+			}
+		}
+	})
+}
@@ -0,0 +1,211 @@
+package scoring
+
+import (
+	"strconv"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// GamesForTiebreak is the game score at which a set goes to a tiebreak
+const GamesForTiebreak = 6
+
+// SetsToWin returns how many sets a player needs to take the match.
+// Grand Slam matches are best of five, everything else best of three.
+func SetsToWin(m *domain.Match) int {
+	if m != nil && m.Tournament != nil && m.Tournament.Category == "Grand Slam" {
+		return 3
+	}
+	return 2
+}
+
+// InTiebreak reports whether the current game is a tiebreak
+func InTiebreak(s domain.ScoreState) bool {
+	return s.GamesP1 == GamesForTiebreak && s.GamesP2 == GamesForTiebreak
+}
+
+// PointValue maps a point string to a comparable number.
+// Regular games use 0/15/30/40/AD, tiebreaks use plain counts.
+func PointValue(p string, tiebreak bool) int {
+	if tiebreak {
+		n, _ := strconv.Atoi(p)
+		return n
+	}
+	switch p {
+	case "15":
+		return 1
+	case "30":
+		return 2
+	case "40":
+		return 3
+	case "AD", "A":
+		return 4
+	default:
+		return 0
+	}
+}
+
+// points returns the score of the given player and their opponent
+func points(s domain.ScoreState, player int) (own, opp int) {
+	tb := InTiebreak(s)
+	p1, p2 := PointValue(s.PointsP1, tb), PointValue(s.PointsP2, tb)
+	if player == 1 {
+		return p1, p2
+	}
+	return p2, p1
+}
+
+// games returns the games of the given player and their opponent
+func games(s domain.ScoreState, player int) (own, opp int) {
+	if player == 1 {
+		return s.GamesP1, s.GamesP2
+	}
+	return s.GamesP2, s.GamesP1
+}
+
+// sets returns the sets of the given player and their opponent
+func sets(s domain.ScoreState, player int) (own, opp int) {
+	if player == 1 {
+		return s.SetsP1, s.SetsP2
+	}
+	return s.SetsP2, s.SetsP1
+}
+
+// IsGamePoint reports whether player wins the current game by winning the next point
+func IsGamePoint(s domain.ScoreState, player int) bool {
+	own, opp := points(s, player)
+	if InTiebreak(s) {
+		return own >= 6 && own > opp
+	}
+	return own == 4 || (own == 3 && opp < 3)
+}
+
+// IsSetPoint reports whether player wins the current set by winning the next point
+func IsSetPoint(s domain.ScoreState, player int) bool {
+	if !IsGamePoint(s, player) {
+		return false
+	}
+	if InTiebreak(s) {
+		return true
+	}
+	own, opp := games(s, player)
+	own++
+	return own >= GamesForTiebreak && own-opp >= 2
+}
+
+// IsMatchPoint reports whether player wins the match by winning the next point
+func IsMatchPoint(s domain.ScoreState, player, setsToWin int) bool {
+	own, _ := sets(s, player)
+	return own == setsToWin-1 && IsSetPoint(s, player)
+}
+
+// NearMatchEnd reports whether a player is a set from winning and within two
+// games of taking that set, e.g. 4-4 in a deciding set
+func NearMatchEnd(s domain.ScoreState, setsToWin int) bool {
+	for _, player := range []int{1, 2} {
+		ownSets, _ := sets(s, player)
+		own, opp := games(s, player)
+		if ownSets == setsToWin-1 && own >= GamesForTiebreak-2 && own >= opp {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBreakPoint reports whether the receiver wins the current game by winning the next point
+func IsBreakPoint(s domain.ScoreState) bool {
+	if InTiebreak(s) || s.Serving == 0 {
+		return false
+	}
+	return IsGamePoint(s, opponent(s.Serving))
+}
+
+// Server returns who serves the next point. Serve alternates every game,
+// with a finished tiebreak counting as one game; inside a tiebreak the
+// player whose turn it is serves one point, then serve changes every two.
+func Server(firstToServe, gamesPlayed, tiebreakPoints int) int {
+	server := firstToServe
+	if gamesPlayed%2 == 1 {
+		server = opponent(server)
+	}
+	if ((tiebreakPoints+1)/2)%2 == 1 {
+		server = opponent(server)
+	}
+	return server
+}
+
+func opponent(player int) int {
+	if player == 1 {
+		return 2
+	}
+	return 1
+}
+
+// ValidScore reports whether a score state could occur in a match where a
+// player needs setsToWin sets
+func ValidScore(s domain.ScoreState, setsToWin int) bool {
+	if s.SetsP1 < 0 || s.SetsP2 < 0 || s.SetsP1 > setsToWin || s.SetsP2 > setsToWin {
+		return false
+	}
+	if s.SetsP1 == setsToWin && s.SetsP2 == setsToWin {
+		return false
+	}
+	if !validGames(s.GamesP1, s.GamesP2) {
+		return false
+	}
+
+	if InTiebreak(s) {
+		p1, err1 := strconv.Atoi(orZero(s.PointsP1))
+		p2, err2 := strconv.Atoi(orZero(s.PointsP2))
+		return err1 == nil && err2 == nil && p1 >= 0 && p2 >= 0
+	}
+	if !validPoint(s.PointsP1) || !validPoint(s.PointsP2) {
+		return false
+	}
+	// Advantage only follows deuce
+	if s.PointsP1 == "AD" {
+		return s.PointsP2 == "40"
+	}
+	if s.PointsP2 == "AD" {
+		return s.PointsP1 == "40"
+	}
+	return true
+}
+
+// validGames checks the games of a set in progress or just completed
+func validGames(g1, g2 int) bool {
+	if g1 < 0 || g2 < 0 || g1 > GamesForTiebreak+1 || g2 > GamesForTiebreak+1 {
+		return false
+	}
+	// Seven games only after 5-5 or a tiebreak
+	if g1 == GamesForTiebreak+1 {
+		return g2 == GamesForTiebreak-1 || g2 == GamesForTiebreak
+	}
+	if g2 == GamesForTiebreak+1 {
+		return g1 == GamesForTiebreak-1 || g1 == GamesForTiebreak
+	}
+	return true
+}
+
+func validPoint(p string) bool {
+	switch p {
+	case "", "0", "15", "30", "40", "AD":
+		return true
+	}
+	return false
+}
+
+func orZero(p string) string {
+	if p == "" {
+		return "0"
+	}
+	return p
+}
+
+// Progress orders score states of one match: a higher value is further
+// into the match
+func Progress(s domain.ScoreState) int {
+	tb := InTiebreak(s)
+	return (s.SetsP1+s.SetsP2)*10000 +
+		(s.GamesP1+s.GamesP2)*100 +
+		PointValue(s.PointsP1, tb) + PointValue(s.PointsP2, tb)
+}
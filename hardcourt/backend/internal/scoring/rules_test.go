@@ -0,0 +1,64 @@
+package scoring
+
+import (
+	"testing"
+
+	"hardcourt/backend/internal/domain"
+)
+
+func TestServer(t *testing.T) {
+	tests := []struct {
+		name           string
+		firstToServe   int
+		gamesPlayed    int
+		tiebreakPoints int
+		want           int
+	}{
+		{"first game", 1, 0, 0, 1},
+		{"second game", 1, 1, 0, 2},
+		{"after a 6-4 set", 2, 10, 0, 2},
+		{"after a 7-6 set", 1, 13, 0, 2},
+		{"tiebreak first point", 1, 12, 0, 1},
+		{"tiebreak second point", 1, 12, 1, 2},
+		{"tiebreak third point", 1, 12, 2, 2},
+		{"tiebreak fourth point", 1, 12, 3, 1},
+		{"tiebreak fifth point", 1, 12, 4, 1},
+		{"tiebreak sixth point", 1, 12, 5, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Server(tt.firstToServe, tt.gamesPlayed, tt.tiebreakPoints); got != tt.want {
+				t.Errorf("Server(%d, %d, %d) = %d, want %d", tt.firstToServe, tt.gamesPlayed, tt.tiebreakPoints, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidScore(t *testing.T) {
+	tests := []struct {
+		name  string
+		score domain.ScoreState
+		want  bool
+	}{
+		{"start", domain.ScoreState{}, true},
+		{"deuce advantage", domain.ScoreState{GamesP1: 3, GamesP2: 2, PointsP1: "AD", PointsP2: "40"}, true},
+		{"advantage without deuce", domain.ScoreState{PointsP1: "AD", PointsP2: "30"}, false},
+		{"tiebreak points", domain.ScoreState{SetsP1: 1, GamesP1: 6, GamesP2: 6, PointsP1: "8", PointsP2: "7"}, true},
+		{"bad point", domain.ScoreState{PointsP1: "45"}, false},
+		{"seven games early", domain.ScoreState{GamesP1: 7, GamesP2: 3}, false},
+		{"too many sets", domain.ScoreState{SetsP1: 3}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidScore(tt.score, 2); got != tt.want {
+				t.Errorf("ValidScore(%+v) = %v, want %v", tt.score, got, tt.want)
+			}
+		})
+	}
+
+	if Progress(domain.ScoreState{SetsP1: 1}) <= Progress(domain.ScoreState{GamesP1: 5, GamesP2: 6, PointsP1: "40", PointsP2: "AD"}) {
+		t.Error("Expected a completed set to outrank any score inside the first set")
+	}
+}
@@ -0,0 +1,184 @@
+package scoring
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"hardcourt/backend/internal/domain"
+)
+
+// FinalSet is how a deciding set at 6-6 is settled
+type FinalSet int
+
+const (
+	// FinalSetTiebreak plays a 7-point tiebreak, as in every other set
+	FinalSetTiebreak FinalSet = iota
+	// FinalSetMatchTiebreak plays a 10-point tiebreak
+	FinalSetMatchTiebreak
+	// FinalSetAdvantage plays on until a player is two games clear
+	FinalSetAdvantage
+)
+
+// ErrMatchOver is returned for points played after the match was won
+var ErrMatchOver = errors.New("match is already over")
+
+// Scorer keeps score point by point from the first serve, tracking who
+// serves: serve alternates every game, and in a tiebreak changes after the
+// first point and then every two. The player who received first in a
+// tiebreak serves the next set.
+type Scorer struct {
+	setsToWin int
+	finalSet  FinalSet
+
+	sets     []domain.SetScore
+	setsWon  [2]int
+	games    [2]int
+	points   [2]int
+	server   int
+	tiebreak bool
+
+	tiebreakServer int // Served the tiebreak's first point
+	tiebreakPoints int
+}
+
+// NewScorer starts a match in which a player needs setsToWin sets (2 if
+// not positive) and firstServer (1 or 2) serves first
+func NewScorer(setsToWin, firstServer int, finalSet FinalSet) *Scorer {
+	if setsToWin <= 0 {
+		setsToWin = 2
+	}
+	return &Scorer{setsToWin: setsToWin, finalSet: finalSet, server: firstServer}
+}
+
+// Server returns who serves the next point
+func (s *Scorer) Server() int {
+	return s.server
+}
+
+// InTiebreak reports whether the next point is in a tiebreak
+func (s *Scorer) InTiebreak() bool {
+	return s.tiebreak
+}
+
+// Finished reports whether a player has won the match
+func (s *Scorer) Finished() bool {
+	return s.setsWon[0] == s.setsToWin || s.setsWon[1] == s.setsToWin
+}
+
+// Winner returns 1 or 2 once the match is won, otherwise 0
+func (s *Scorer) Winner() int {
+	switch {
+	case s.setsWon[0] == s.setsToWin:
+		return 1
+	case s.setsWon[1] == s.setsToWin:
+		return 2
+	}
+	return 0
+}
+
+// Sets returns the completed sets
+func (s *Scorer) Sets() []domain.SetScore {
+	return append([]domain.SetScore(nil), s.sets...)
+}
+
+// State returns the score before the next point, with the server
+func (s *Scorer) State() domain.ScoreState {
+	state := domain.ScoreState{
+		SetsP1: s.setsWon[0], SetsP2: s.setsWon[1],
+		GamesP1: s.games[0], GamesP2: s.games[1],
+		Serving: s.server,
+	}
+	if s.tiebreak {
+		state.PointsP1 = strconv.Itoa(s.points[0])
+		state.PointsP2 = strconv.Itoa(s.points[1])
+		return state
+	}
+	state.PointsP1, state.PointsP2 = gamePoints(s.points[0], s.points[1])
+	return state
+}
+
+// Point records a point won by player 1 or 2
+func (s *Scorer) Point(winner int) error {
+	if winner != 1 && winner != 2 {
+		return fmt.Errorf("point winner must be 1 or 2, got %d", winner)
+	}
+	if s.Finished() {
+		return ErrMatchOver
+	}
+
+	w, l := winner-1, 2-winner
+	s.points[w]++
+
+	if s.tiebreak {
+		s.tiebreakPoints++
+		target := 7
+		if s.deciding() && s.finalSet == FinalSetMatchTiebreak {
+			target = 10
+		}
+		if s.points[w] >= target && s.points[w]-s.points[l] >= 2 {
+			s.winGame(winner)
+		} else if s.tiebreakPoints%2 == 1 {
+			s.server = opponent(s.server)
+		}
+		return nil
+	}
+
+	if s.points[w] >= 4 && s.points[w]-s.points[l] >= 2 {
+		s.winGame(winner)
+	}
+	return nil
+}
+
+func (s *Scorer) winGame(winner int) {
+	w, l := winner-1, 2-winner
+	wasTiebreak := s.tiebreak
+	tiebreakPoints := s.points
+
+	s.games[w]++
+	s.points = [2]int{}
+	s.tiebreak = false
+	if wasTiebreak {
+		s.server = opponent(s.tiebreakServer)
+	} else {
+		s.server = opponent(s.server)
+	}
+
+	if wasTiebreak || (s.games[w] >= GamesForTiebreak && s.games[w]-s.games[l] >= 2) {
+		set := domain.SetScore{SetNumber: len(s.sets) + 1, GamesP1: s.games[0], GamesP2: s.games[1]}
+		if wasTiebreak {
+			set.TiebreakP1, set.TiebreakP2 = tiebreakPoints[0], tiebreakPoints[1]
+		}
+		s.sets = append(s.sets, set)
+		s.setsWon[w]++
+		s.games = [2]int{}
+		return
+	}
+
+	if s.games[0] == GamesForTiebreak && s.games[1] == GamesForTiebreak &&
+		!(s.deciding() && s.finalSet == FinalSetAdvantage) {
+		s.tiebreak = true
+		s.tiebreakServer = s.server
+		s.tiebreakPoints = 0
+	}
+}
+
+// deciding reports whether the current set is the last possible one
+func (s *Scorer) deciding() bool {
+	return s.setsWon[0] == s.setsToWin-1 && s.setsWon[1] == s.setsToWin-1
+}
+
+// gamePoints renders regular game points as 0/15/30/40/AD
+func gamePoints(p1, p2 int) (string, string) {
+	if p1 >= 3 && p2 >= 3 {
+		switch {
+		case p1 > p2:
+			return "AD", "40"
+		case p2 > p1:
+			return "40", "AD"
+		}
+		return "40", "40"
+	}
+	calls := []string{"0", "15", "30", "40"}
+	return calls[p1], calls[p2]
+}
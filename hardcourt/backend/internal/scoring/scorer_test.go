@@ -0,0 +1,123 @@
+package scoring
+
+import (
+	"errors"
+	"testing"
+)
+
+// playPoints records the points in order, failing the test on an error
+func playPoints(t *testing.T, s *Scorer, winners ...int) {
+	t.Helper()
+	for i, w := range winners {
+		if err := s.Point(w); err != nil {
+			t.Fatalf("Point %d: unexpected error: %v", i+1, err)
+		}
+	}
+}
+
+// playGames gives player winner n games in a row from love
+func playGames(t *testing.T, s *Scorer, winner, n int) {
+	t.Helper()
+	for i := 0; i < n*4; i++ {
+		playPoints(t, s, winner)
+	}
+}
+
+func TestScorer_GamePoints(t *testing.T) {
+	s := NewScorer(2, 1, FinalSetTiebreak)
+
+	playPoints(t, s, 1, 2, 1, 2, 1, 2)
+	state := s.State()
+	if state.PointsP1 != "40" || state.PointsP2 != "40" {
+		t.Errorf("Expected deuce, got %s-%s", state.PointsP1, state.PointsP2)
+	}
+
+	playPoints(t, s, 2)
+	if state := s.State(); state.PointsP2 != "AD" {
+		t.Errorf("Expected advantage player 2, got %s-%s", state.PointsP1, state.PointsP2)
+	}
+
+	playPoints(t, s, 2)
+	state = s.State()
+	if state.GamesP2 != 1 || state.PointsP1 != "0" || state.PointsP2 != "0" {
+		t.Errorf("Expected 0-1 in games after the break, got %+v", state)
+	}
+	if s.Server() != 2 {
+		t.Errorf("Expected player 2 to serve the second game, got %d", s.Server())
+	}
+}
+
+func TestScorer_TiebreakServe(t *testing.T) {
+	s := NewScorer(2, 1, FinalSetTiebreak)
+	for i := 0; i < 6; i++ {
+		playGames(t, s, 1, 1)
+		playGames(t, s, 2, 1)
+	}
+	if !s.InTiebreak() {
+		t.Fatal("Expected a tiebreak at 6-6")
+	}
+
+	// Player 1 serves the first point, then each player serves two
+	servers := []int{1, 2, 2, 1, 1, 2, 2}
+	for i, want := range servers {
+		if s.Server() != want {
+			t.Errorf("Tiebreak point %d: expected player %d to serve, got %d", i+1, want, s.Server())
+		}
+		playPoints(t, s, 1)
+	}
+
+	sets := s.Sets()
+	if len(sets) != 1 || sets[0].GamesP1 != 7 || sets[0].GamesP2 != 6 || sets[0].TiebreakP1 != 7 || sets[0].TiebreakP2 != 0 {
+		t.Errorf("Expected 7-6(0), got %+v", sets)
+	}
+	if s.Server() != 2 {
+		t.Errorf("Expected player 2 to serve the next set, got %d", s.Server())
+	}
+}
+
+func TestScorer_FinalSetRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     FinalSet
+		tiebreak bool
+		target   int
+	}{
+		{"tiebreak", FinalSetTiebreak, true, 7},
+		{"match tiebreak", FinalSetMatchTiebreak, true, 10},
+		{"advantage", FinalSetAdvantage, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewScorer(2, 1, tt.rule)
+			playGames(t, s, 1, 6)
+			playGames(t, s, 2, 6)
+			for i := 0; i < 6; i++ {
+				playGames(t, s, 1, 1)
+				playGames(t, s, 2, 1)
+			}
+
+			if s.InTiebreak() != tt.tiebreak {
+				t.Fatalf("Expected tiebreak %v at 6-6 in the third set, got %v", tt.tiebreak, s.InTiebreak())
+			}
+			if !tt.tiebreak {
+				playGames(t, s, 1, 2)
+			} else {
+				for i := 0; i < tt.target-1; i++ {
+					playPoints(t, s, 1)
+				}
+				if s.Finished() {
+					t.Fatalf("Expected the tiebreak to go to %d points", tt.target)
+				}
+				playPoints(t, s, 1)
+			}
+
+			if s.Winner() != 1 {
+				t.Errorf("Expected player 1 to win, got %d", s.Winner())
+			}
+			if err := s.Point(2); !errors.Is(err, ErrMatchOver) {
+				t.Errorf("Expected ErrMatchOver after the last point, got %v", err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -12,91 +13,62 @@ import (
 	"github.com/PuerkitoBio/goquery"
 
 	"hardcourt/backend/internal/domain"
-	"hardcourt/backend/internal/repository"
+	"hardcourt/backend/internal/providers"
+	"hardcourt/backend/internal/scoring"
 )
 
 // ATPTourScraper scrapes live data from atptour.com
 type ATPTourScraper struct {
-	tournamentRepo *repository.TournamentRepository
-	playerRepo     *repository.PlayerRepository
-	matchRepo      *repository.MatchRepository
-	httpClient     *http.Client
+	httpClient *http.Client
+	baseURL    string
+	now        func() time.Time
 }
 
-// NewATPTourScraper creates a new ATP Tour scraper
-func NewATPTourScraper(
-	tournamentRepo *repository.TournamentRepository,
-	playerRepo *repository.PlayerRepository,
-	matchRepo *repository.MatchRepository,
-) *ATPTourScraper {
+var _ providers.Provider = (*ATPTourScraper)(nil)
+
+// NewATPTourScraper creates a new ATP Tour scraper. Options override the
+// base URL and HTTP client, e.g. for tests.
+func NewATPTourScraper(opts ...providers.Option) *ATPTourScraper {
+	config := providers.ApplyOptions(providers.HTTPConfig{
+		BaseURL: ATPBaseURL,
+		Client:  newHTTPClient(),
+	}, opts)
+
 	return &ATPTourScraper{
-		tournamentRepo: tournamentRepo,
-		playerRepo:     playerRepo,
-		matchRepo:      matchRepo,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-			Transport: &http.Transport{
-				MaxIdleConns:       10,
-				IdleConnTimeout:    30 * time.Second,
-				DisableCompression: false,
-			},
-		},
+		httpClient: config.Client,
+		baseURL:    config.BaseURL,
+		now:        config.Now,
 	}
 }
 
-// ATP Tour URLs
+// ATP Tour URLs, relative to ATPBaseURL
 const (
-	ATPRankingsPage    = "https://www.atptour.com/en/rankings/singles"
-	ATPTournamentsPage = "https://www.atptour.com/en/tournaments"
-	ATPScoresPage      = "https://www.atptour.com/en/scores/current"
+	ATPBaseURL         = "https://www.atptour.com"
+	ATPRankingsPage    = "/en/rankings/singles"
+	ATPTournamentsPage = "/en/tournaments"
+	ATPScoresPage      = "/en/scores/current"
 )
 
-// ScrapeAll scrapes all live ATP data
-func (s *ATPTourScraper) ScrapeAll(ctx context.Context) error {
-	log.Println("🕷️  Starting live ATP Tour web scraping...")
-
-	// Scrape rankings
-	if err := s.ScrapeRankings(ctx); err != nil {
-		log.Printf("⚠️  Rankings scraping error: %v", err)
-	}
-
-	// Scrape current tournaments
-	if err := s.ScrapeTournaments(ctx); err != nil {
-		log.Printf("⚠️  Tournaments scraping error: %v", err)
-	}
+// Name identifies the ATP Tour site in provider config
+func (s *ATPTourScraper) Name() string { return "atp" }
 
-	// Scrape live scores
-	if err := s.ScrapeLiveScores(ctx); err != nil {
-		log.Printf("⚠️  Live scores scraping error: %v", err)
-	}
-
-	log.Println("✅ ATP Tour scraping complete!")
-	return nil
+// fetch downloads and parses an atptour.com page
+func (s *ATPTourScraper) fetch(ctx context.Context, page string) (*goquery.Document, error) {
+	return fetchDocument(ctx, s.httpClient, s.baseURL+page, map[string]string{
+		"User-Agent": "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36",
+	})
 }
 
-// ScrapeRankings scrapes current ATP rankings from atptour.com
-func (s *ATPTourScraper) ScrapeRankings(ctx context.Context) error {
+// Rankings scrapes current ATP rankings from atptour.com
+func (s *ATPTourScraper) Rankings(ctx context.Context) ([]*domain.Player, error) {
 	log.Println("📊 Scraping ATP rankings from atptour.com...")
 
-	req, err := http.NewRequestWithContext(ctx, "GET", ATPRankingsPage, nil)
+	doc, err := s.fetch(ctx, ATPRankingsPage)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36")
-
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to fetch rankings page: %w", err)
-	}
-	defer resp.Body.Close()
-
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to parse HTML: %w", err)
-	}
-
-	updateCount := 0
+	var players []*domain.Player
 
 	// Parse rankings table
 	doc.Find("table.mega-table tbody tr").Each(func(i int, row *goquery.Selection) {
@@ -113,63 +85,29 @@ func (s *ATPTourScraper) ScrapeRankings(ctx context.Context) error {
 		rankNum, _ := strconv.Atoi(rank)
 		pointsNum, _ := strconv.Atoi(strings.ReplaceAll(pointsText, ",", ""))
 
-		// Generate player ID
-		playerID := s.generatePlayerID(playerName)
-
-		// Update or create player
-		player, err := s.playerRepo.GetByID(ctx, playerID)
-		if err != nil || player == nil {
-			// Create new player
-			player = &domain.Player{
-				ID:          playerID,
-				Name:        playerName,
-				CountryCode: "XX", // Will be updated by detailed scrape
-				Rank:        rankNum,
-				Points:      pointsNum,
-			}
-			if err := s.playerRepo.Create(ctx, player); err != nil {
-				if !strings.Contains(err.Error(), "duplicate") {
-					log.Printf("Failed to create player %s: %v", playerName, err)
-				}
-			} else {
-				updateCount++
-			}
-		} else {
-			// Update existing player's rank and points
-			player.Rank = rankNum
-			player.Points = pointsNum
-			// Update logic would go here if we had an Update method
-			updateCount++
-		}
+		// Country code is left empty for the sink to fill from existing data
+		players = append(players, &domain.Player{
+			ID:     domain.PlayerIDFromName(playerName),
+			Name:   playerName,
+			Rank:   rankNum,
+			Points: pointsNum,
+		})
 	})
 
-	log.Printf("✅ Scraped %d player rankings", updateCount)
-	return nil
+	log.Printf("✅ Scraped %d player rankings", len(players))
+	return players, nil
 }
 
-// ScrapeTournaments scrapes current and upcoming tournaments
-func (s *ATPTourScraper) ScrapeTournaments(ctx context.Context) error {
+// Tournaments scrapes current and upcoming tournaments
+func (s *ATPTourScraper) Tournaments(ctx context.Context) ([]*domain.Tournament, error) {
 	log.Println("🏆 Scraping ATP tournaments from atptour.com...")
 
-	req, err := http.NewRequestWithContext(ctx, "GET", ATPTournamentsPage, nil)
+	doc, err := s.fetch(ctx, ATPTournamentsPage)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36")
-
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to fetch tournaments page: %w", err)
-	}
-	defer resp.Body.Close()
-
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to parse HTML: %w", err)
-	}
-
-	updateCount := 0
+	var tournaments []*domain.Tournament
 
 	// Parse tournament listings
 	doc.Find(".tournament-item, .tourney-result").Each(func(i int, item *goquery.Selection) {
@@ -182,75 +120,42 @@ func (s *ATPTourScraper) ScrapeTournaments(ctx context.Context) error {
 			return
 		}
 
-		// Parse tournament data and create/update
-		tourneyID := s.generateTournamentID(tourneyName, time.Now().Year())
-
-		tournament := &domain.Tournament{
-			ID:      tourneyID,
+		tournaments = append(tournaments, &domain.Tournament{
+			ID:      providers.TournamentID(tourneyName, s.now().Year()),
 			Name:    tourneyName,
-			Surface: surface,
+			Surface: providers.Surface(surface),
 			City:    location,
-			Year:    time.Now().Year(),
-			Status:  "upcoming",
-		}
-
-		// Check if exists
-		existing, err := s.tournamentRepo.GetByID(ctx, tourneyID)
-		if err != nil || existing == nil {
-			if err := s.tournamentRepo.Create(ctx, tournament); err != nil {
-				if !strings.Contains(err.Error(), "duplicate") {
-					log.Printf("Failed to create tournament %s: %v", tourneyName, err)
-				}
-			} else {
-				updateCount++
-			}
-		}
+			Year:    s.now().Year(),
+			Source:  "atp",
+		})
 	})
 
-	log.Printf("✅ Scraped %d tournaments", updateCount)
-	return nil
+	log.Printf("✅ Scraped %d tournaments", len(tournaments))
+	return tournaments, nil
 }
 
-// ScrapeLiveScores scrapes live match scores
-func (s *ATPTourScraper) ScrapeLiveScores(ctx context.Context) error {
+// LiveMatches scrapes live match scores
+func (s *ATPTourScraper) LiveMatches(ctx context.Context) ([]*domain.Match, error) {
 	log.Println("🎾 Scraping live scores from atptour.com...")
 
-	req, err := http.NewRequestWithContext(ctx, "GET", ATPScoresPage, nil)
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36")
-
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to fetch scores page: %w", err)
-	}
-	defer resp.Body.Close()
-
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	doc, err := s.fetch(ctx, ATPScoresPage)
 	if err != nil {
-		return fmt.Errorf("failed to parse HTML: %w", err)
+		return nil, err
 	}
 
-	updateCount := 0
+	var matches []*domain.Match
 
 	// Parse live matches
 	doc.Find(".match-item, .day-table tbody tr").Each(func(i int, match *goquery.Selection) {
 		player1 := strings.TrimSpace(match.Find(".player-left, .player1").Text())
 		player2 := strings.TrimSpace(match.Find(".player-right, .player2").Text())
-		_ = strings.TrimSpace(match.Find(".score").Text()) // score - for future parsing
+		score := strings.TrimSpace(match.Find(".score").Text())
 		status := strings.TrimSpace(match.Find(".status").Text())
 
 		if player1 == "" || player2 == "" {
 			return
 		}
 
-		// Create or update match
-		player1ID := s.generatePlayerID(player1)
-		player2ID := s.generatePlayerID(player2)
-		matchID := fmt.Sprintf("live-%s-vs-%s-%d", player1ID, player2ID, time.Now().Unix())
-
 		matchStatus := domain.StatusScheduled
 		if strings.Contains(strings.ToLower(status), "live") || strings.Contains(strings.ToLower(status), "in progress") {
 			matchStatus = domain.StatusLive
@@ -258,45 +163,120 @@ func (s *ATPTourScraper) ScrapeLiveScores(ctx context.Context) error {
 			matchStatus = domain.StatusFinished
 		}
 
-		matchObj := &domain.Match{
-			ID:          matchID,
-			Player1ID:   player1ID,
-			Player2ID:   player2ID,
-			Status:      matchStatus,
-			StartTime:   time.Now(),
-			IsSimulated: false,
-		}
-
-		// Check if exists
-		existing, err := s.matchRepo.GetByID(ctx, matchID)
-		if err != nil || existing == nil {
-			if err := s.matchRepo.Create(ctx, matchObj); err != nil {
-				if !strings.Contains(err.Error(), "duplicate") && !strings.Contains(err.Error(), "foreign key") {
-					log.Printf("Failed to create match %s: %v", matchID, err)
-				}
-			} else {
-				updateCount++
-			}
+		m := liveMatch(player1, player2, matchStatus, s.now())
+		if found := notBeforePattern.FindStringSubmatch(status); found != nil {
+			m.NotBefore = found[1]
 		}
+		applyScore(m, score)
+		matches = append(matches, m)
 	})
 
-	log.Printf("✅ Scraped %d live scores", updateCount)
-	return nil
+	log.Printf("✅ Scraped %d live scores", len(matches))
+	return matches, nil
+}
+
+// ScheduledMatches is not available from atptour.com
+func (s *ATPTourScraper) ScheduledMatches(ctx context.Context, day time.Time) ([]*domain.Match, error) {
+	return nil, providers.ErrUnsupported
+}
+
+// MatchDetail is not available from atptour.com
+func (s *ATPTourScraper) MatchDetail(ctx context.Context, matchID string) (*domain.Match, error) {
+	return nil, providers.ErrUnsupported
 }
 
 // Helper functions
-func (s *ATPTourScraper) generatePlayerID(name string) string {
-	id := strings.ToLower(name)
-	id = strings.ReplaceAll(id, ".", "")
-	id = strings.ReplaceAll(id, " ", "-")
-	id = strings.TrimSpace(id)
-	return id
+
+// liveMatch builds a match between two scraped player names. Scraped pages
+// carry no stable match ID, so the ID is derived from the players and the
+// day, letting repeated polls update the same match. They carry no start
+// time either, and the poll time is not one: it would tie the match to the
+// day it was polled when reconciled with other sources.
+func liveMatch(player1, player2 string, status domain.MatchStatus, now time.Time) *domain.Match {
+	player1ID := domain.PlayerIDFromName(player1)
+	player2ID := domain.PlayerIDFromName(player2)
+
+	return &domain.Match{
+		ID:          fmt.Sprintf("live-%s-vs-%s-%s", player1ID, player2ID, now.Format("20060102")),
+		Player1ID:   player1ID,
+		Player2ID:   player2ID,
+		Player1:     &domain.Player{ID: player1ID, Name: player1},
+		Player2:     &domain.Player{ID: player2ID, Name: player2},
+		Status:      status,
+		IsSimulated: false,
+	}
 }
 
-func (s *ATPTourScraper) generateTournamentID(name string, year int) string {
-	id := strings.ToLower(name)
-	id = strings.ReplaceAll(id, " ", "-")
-	id = strings.ReplaceAll(id, "'", "")
-	id = strings.TrimSpace(id)
-	return fmt.Sprintf("%s-%d", id, year)
+// notBeforePattern reads the local start time from "Not Before 19:00"
+var notBeforePattern = regexp.MustCompile(`(?i)not before\s+(\d{1,2}:\d{2})`)
+
+// applyScore parses a scraped score string onto the match. The parsed status
+// only overrides the page's when the page is vague or the match ended early.
+func applyScore(match *domain.Match, text string) {
+	parsed, err := scoring.ParseScore(text, 0)
+	if err != nil {
+		log.Printf("⚠️  Ignoring score %q for %s: %v", text, match.ID, err)
+		return
+	}
+	if parsed.Status == domain.StatusScheduled {
+		return
+	}
+
+	match.Sets = parsed.Sets
+	match.Score = parsed.Score
+	if match.Status == domain.StatusScheduled || parsed.Outcome != scoring.OutcomeCompleted {
+		match.Status = parsed.Status
+	}
+	if match.Status != domain.StatusFinished {
+		return
+	}
+	var winner string
+	switch parsed.Winner() {
+	case 1:
+		winner = match.Player1ID
+	case 2:
+		winner = match.Player2ID
+	default:
+		return
+	}
+	match.WinnerID = &winner
+}
+
+// newHTTPClient returns the default client for scraping HTML pages
+func newHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			MaxIdleConns:       10,
+			IdleConnTimeout:    30 * time.Second,
+			DisableCompression: false,
+		},
+	}
+}
+
+// fetchDocument downloads and parses an HTML page
+func fetchDocument(ctx context.Context, client *http.Client, url string, headers map[string]string) (*goquery.Document, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %d", url, resp.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+	return doc, nil
 }
@@ -11,73 +11,63 @@ import (
 	"github.com/PuerkitoBio/goquery"
 
 	"hardcourt/backend/internal/domain"
-	"hardcourt/backend/internal/repository"
+	"hardcourt/backend/internal/providers"
 )
 
 // FlashScoreScraper scrapes live tennis scores from FlashScore
 type FlashScoreScraper struct {
-	matchRepo  *repository.MatchRepository
-	playerRepo *repository.PlayerRepository
 	httpClient *http.Client
+	baseURL    string
+	now        func() time.Time
 }
 
-// NewFlashScoreScraper creates a new FlashScore scraper
-func NewFlashScoreScraper(
-	matchRepo *repository.MatchRepository,
-	playerRepo *repository.PlayerRepository,
-) *FlashScoreScraper {
+var _ providers.Provider = (*FlashScoreScraper)(nil)
+
+// NewFlashScoreScraper creates a new FlashScore scraper. Options override
+// the base URL and HTTP client, e.g. for tests.
+func NewFlashScoreScraper(opts ...providers.Option) *FlashScoreScraper {
+	config := providers.ApplyOptions(providers.HTTPConfig{
+		BaseURL: FlashScoreBaseURL,
+		Client:  newHTTPClient(),
+	}, opts)
+
 	return &FlashScoreScraper{
-		matchRepo:  matchRepo,
-		playerRepo: playerRepo,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-			Transport: &http.Transport{
-				MaxIdleConns:       10,
-				IdleConnTimeout:    30 * time.Second,
-				DisableCompression: false,
-			},
-		},
+		httpClient: config.Client,
+		baseURL:    config.BaseURL,
+		now:        config.Now,
 	}
 }
 
+// FlashScore URLs, relative to FlashScoreBaseURL
 const (
-	FlashScoreTennisURL = "https://www.flashscore.com/tennis/"
+	FlashScoreBaseURL   = "https://www.flashscore.com"
+	FlashScoreTennisURL = "/tennis/"
 )
 
-// ScrapeLiveMatches scrapes live tennis matches from FlashScore
-func (s *FlashScoreScraper) ScrapeLiveMatches(ctx context.Context) error {
-	log.Println("⚡ Scraping live matches from FlashScore...")
+// Name identifies FlashScore in provider config
+func (s *FlashScoreScraper) Name() string { return "flashscore" }
 
-	req, err := http.NewRequestWithContext(ctx, "GET", FlashScoreTennisURL, nil)
-	if err != nil {
-		return err
-	}
+// LiveMatches scrapes live tennis matches from FlashScore
+func (s *FlashScoreScraper) LiveMatches(ctx context.Context) ([]*domain.Match, error) {
+	log.Println("⚡ Scraping live matches from FlashScore...")
 
 	// Mimic browser
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36")
-	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/webp,*/*;q=0.8")
-	req.Header.Set("Accept-Language", "en-US,en;q=0.5")
-
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to fetch FlashScore: %w", err)
-	}
-	defer resp.Body.Close()
-
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	doc, err := fetchDocument(ctx, s.httpClient, s.baseURL+FlashScoreTennisURL, map[string]string{
+		"User-Agent":      "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+		"Accept":          "text/html,application/xhtml+xml,application/xml;q=0.9,image/webp,*/*;q=0.8",
+		"Accept-Language": "en-US,en;q=0.5",
+	})
 	if err != nil {
-		return fmt.Errorf("failed to parse HTML: %w", err)
+		return nil, fmt.Errorf("failed to fetch FlashScore: %w", err)
 	}
 
-	updateCount := 0
+	var matches []*domain.Match
 
 	// Parse live matches
 	doc.Find(".event__match, .sportName.tennis .event").Each(func(i int, match *goquery.Selection) {
 		// Extract match data
-		player1 := strings.TrimSpace(match.Find(".event__participant--home").Text())
-		player2 := strings.TrimSpace(match.Find(".event__participant--away").Text())
-		score1 := strings.TrimSpace(match.Find(".event__score--home").Text())
-		score2 := strings.TrimSpace(match.Find(".event__score--away").Text())
+		player1 := participantName(match.Find(".event__participant--home").Text())
+		player2 := participantName(match.Find(".event__participant--away").Text())
 		status := strings.TrimSpace(match.Find(".event__stage").Text())
 		_ = strings.TrimSpace(match.Find(".event__title").Text()) // tournament - for future use
 
@@ -85,10 +75,6 @@ func (s *FlashScoreScraper) ScrapeLiveMatches(ctx context.Context) error {
 			return
 		}
 
-		player1ID := s.generatePlayerID(player1)
-		player2ID := s.generatePlayerID(player2)
-		matchID := fmt.Sprintf("live-%s-vs-%s-%d", player1ID, player2ID, time.Now().Unix())
-
 		// Determine match status
 		matchStatus := domain.StatusLive
 		if strings.Contains(strings.ToLower(status), "finished") ||
@@ -99,42 +85,77 @@ func (s *FlashScoreScraper) ScrapeLiveMatches(ctx context.Context) error {
 			matchStatus = domain.StatusScheduled
 		}
 
-		matchObj := &domain.Match{
-			ID:          matchID,
-			Player1ID:   player1ID,
-			Player2ID:   player2ID,
-			Status:      matchStatus,
-			StartTime:   time.Now(),
-			IsSimulated: false,
-		}
+		matchObj := liveMatch(player1, player2, matchStatus, s.now())
+		applyScore(matchObj, flashScoreScore(match, status))
 
-		// Try to parse scores
-		if score1 != "" && score2 != "" {
-			// Parse set scores (e.g., "6", "7", "6")
-			// This is simplified - real implementation would parse detailed scores
-		}
+		matches = append(matches, matchObj)
+	})
+
+	log.Printf("✅ Scraped %d live matches from FlashScore", len(matches))
+	return matches, nil
+}
+
+// flashScoreScore builds a score string from the per-set game cells. Tiebreak
+// points sit in a <sup> inside the games cell.
+func flashScoreScore(match *goquery.Selection, stage string) string {
+	home := match.Find(".event__part--home")
+	away := match.Find(".event__part--away")
 
-		// Check if match exists
-		existing, err := s.matchRepo.GetByID(ctx, matchID)
-		if err != nil || existing == nil {
-			if err := s.matchRepo.Create(ctx, matchObj); err != nil {
-				if !strings.Contains(err.Error(), "duplicate") && !strings.Contains(err.Error(), "foreign key") {
-					log.Printf("Failed to create match from FlashScore: %v", err)
-				}
-			} else {
-				updateCount++
-			}
+	var sets []string
+	home.Each(func(i int, part *goquery.Selection) {
+		if i >= away.Length() {
+			return
+		}
+		other := away.Eq(i)
+		set := partGames(part) + "-" + partGames(other)
+		tb1 := strings.TrimSpace(part.Find("sup").Text())
+		tb2 := strings.TrimSpace(other.Find("sup").Text())
+		if tb1 != "" && tb2 != "" {
+			set += "(" + tb1 + "-" + tb2 + ")"
 		}
+		sets = append(sets, set)
 	})
 
-	log.Printf("✅ Scraped %d live matches from FlashScore", updateCount)
-	return nil
+	stage = strings.ToLower(stage)
+	switch {
+	case strings.Contains(stage, "walkover"):
+		sets = append(sets, "W/O")
+	case strings.Contains(stage, "retired"):
+		sets = append(sets, "RET")
+	}
+	return strings.Join(sets, " ")
+}
+
+// partGames returns the games in a set cell without its tiebreak superscript
+func partGames(part *goquery.Selection) string {
+	return strings.TrimSpace(part.Clone().Children().Remove().End().Text())
+}
+
+// participantName drops the country FlashScore appends: "Sinner J. (Ita)" -> "Sinner J."
+func participantName(text string) string {
+	name := strings.TrimSpace(text)
+	if i := strings.LastIndex(name, " ("); i > 0 && strings.HasSuffix(name, ")") {
+		name = name[:i]
+	}
+	return name
+}
+
+// ScheduledMatches is not scraped from FlashScore
+func (s *FlashScoreScraper) ScheduledMatches(ctx context.Context, day time.Time) ([]*domain.Match, error) {
+	return nil, providers.ErrUnsupported
+}
+
+// Rankings is not scraped from FlashScore
+func (s *FlashScoreScraper) Rankings(ctx context.Context) ([]*domain.Player, error) {
+	return nil, providers.ErrUnsupported
+}
+
+// Tournaments is not scraped from FlashScore
+func (s *FlashScoreScraper) Tournaments(ctx context.Context) ([]*domain.Tournament, error) {
+	return nil, providers.ErrUnsupported
 }
 
-func (s *FlashScoreScraper) generatePlayerID(name string) string {
-	id := strings.ToLower(name)
-	id = strings.ReplaceAll(id, ".", "")
-	id = strings.ReplaceAll(id, " ", "-")
-	id = strings.TrimSpace(id)
-	return id
+// MatchDetail is not scraped from FlashScore
+func (s *FlashScoreScraper) MatchDetail(ctx context.Context, matchID string) (*domain.Match, error) {
+	return nil, providers.ErrUnsupported
 }
@@ -0,0 +1,91 @@
+package scraper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/providers"
+	"hardcourt/backend/internal/testutil"
+)
+
+// fixtureTime is the clock for scraped pages, which carry no dates of their own
+var fixtureTime = time.Date(2025, 1, 26, 8, 30, 0, 0, time.UTC)
+
+func newATPFixtureScraper(t *testing.T) *ATPTourScraper {
+	t.Helper()
+	server := testutil.FixtureServer(t, map[string]string{
+		ATPRankingsPage:    "atp_rankings.html",
+		ATPTournamentsPage: "atp_tournaments.html",
+		ATPScoresPage:      "atp_scores.html",
+	})
+
+	s := NewATPTourScraper(providers.WithBaseURL(server.URL), providers.WithHTTPClient(server.Client()))
+	s.now = func() time.Time { return fixtureTime }
+	return s
+}
+
+func TestATPTourScraper_Rankings(t *testing.T) {
+	players, err := newATPFixtureScraper(t).Rankings(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to scrape rankings: %v", err)
+	}
+	if len(players) != 3 {
+		t.Fatalf("Expected 3 ranked players, got %d", len(players))
+	}
+	testutil.Golden(t, "atp_rankings", players)
+}
+
+func TestATPTourScraper_Tournaments(t *testing.T) {
+	tournaments, err := newATPFixtureScraper(t).Tournaments(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to scrape tournaments: %v", err)
+	}
+	if len(tournaments) != 3 {
+		t.Fatalf("Expected 3 tournaments, got %d", len(tournaments))
+	}
+	testutil.Golden(t, "atp_tournaments", tournaments)
+}
+
+func TestATPTourScraper_LiveMatches(t *testing.T) {
+	matches, err := newATPFixtureScraper(t).LiveMatches(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to scrape scores: %v", err)
+	}
+	if len(matches) != 4 {
+		t.Fatalf("Expected 4 matches, got %d", len(matches))
+	}
+	// The score alone tells us the unlabelled match ended in a retirement
+	if retired := matches[3]; retired.Status != domain.StatusFinished || retired.WinnerID != nil {
+		t.Errorf("Expected a finished match without a winner, got %s", retired.Status)
+	}
+	testutil.Golden(t, "atp_scores", matches)
+}
+
+func TestATPTourScraper_HTTPError(t *testing.T) {
+	// A page missing from the fixture server is a 404, which must not parse as an empty page
+	server := testutil.FixtureServer(t, nil)
+	s := NewATPTourScraper(providers.WithBaseURL(server.URL), providers.WithHTTPClient(server.Client()))
+
+	if _, err := s.Rankings(context.Background()); err == nil {
+		t.Error("Expected an error for a 404 response")
+	}
+}
+
+func TestFlashScoreScraper_LiveMatches(t *testing.T) {
+	server := testutil.FixtureServer(t, map[string]string{
+		FlashScoreTennisURL: "flashscore_tennis.html",
+	})
+	s := NewFlashScoreScraper(providers.WithBaseURL(server.URL), providers.WithHTTPClient(server.Client()))
+	s.now = func() time.Time { return fixtureTime }
+
+	matches, err := s.LiveMatches(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to scrape FlashScore: %v", err)
+	}
+	if len(matches) != 5 {
+		t.Fatalf("Expected 5 matches, got %d", len(matches))
+	}
+	testutil.Golden(t, "flashscore_live", matches)
+}
@@ -8,24 +8,38 @@ import (
 	"time"
 
 	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/providers"
+	"hardcourt/backend/internal/reconcile"
 	"hardcourt/backend/internal/repository"
-	"golang.org/x/time/rate"
+	"hardcourt/backend/internal/scoring"
 )
 
-// Aggregator combines multiple data sources with fallback logic
+// Aggregator is the sink for every data provider: it persists what the
+// providers fetch, caches live matches and forwards updates and typed events
+// to the live channels
 type Aggregator struct {
-	sofascore      *SofascoreClient
 	matchRepo      *repository.MatchRepository
 	playerRepo     *repository.PlayerRepository
 	tournamentRepo *repository.TournamentRepository
 
-	// Rate limiting
-	limiter *rate.Limiter
-
 	// Caching
-	cache      map[string]*domain.Match
-	cacheMu    sync.RWMutex
+	cache       map[string]*domain.Match
+	cacheMu     sync.RWMutex
 	cacheExpiry time.Duration
+
+	// Event detection between successive polls
+	tracker *scoring.Tracker
+
+	// Merges reports of the same match from several providers
+	reconciler     *reconcile.Reconciler
+	provenanceRepo *repository.ProvenanceRepository
+
+	// Live outputs, set by Forward
+	updateChan chan *domain.Match
+	eventChan  chan *domain.MatchEvent
+
+	// Called when a stored match becomes finished, set by OnFinished
+	finished func(*domain.Match)
 }
 
 func NewAggregator(
@@ -34,78 +48,200 @@ func NewAggregator(
 	tournamentRepo *repository.TournamentRepository,
 ) *Aggregator {
 	return &Aggregator{
-		sofascore:      NewSofascoreClient(),
 		matchRepo:      matchRepo,
 		playerRepo:     playerRepo,
 		tournamentRepo: tournamentRepo,
-		limiter:        rate.NewLimiter(rate.Every(2*time.Second), 1), // 1 request every 2 seconds
 		cache:          make(map[string]*domain.Match),
 		cacheExpiry:    30 * time.Second,
+		tracker:        scoring.NewTracker(),
+		reconciler:     reconcile.NewReconciler(reconcile.DefaultPriority, reconcile.DefaultMaxAge),
 	}
 }
 
-// FetchLiveMatches retrieves live matches from all available sources
-func (a *Aggregator) FetchLiveMatches(ctx context.Context) ([]*domain.Match, error) {
-	// Rate limiting
-	if err := a.limiter.Wait(ctx); err != nil {
-		return nil, fmt.Errorf("rate limit exceeded: %w", err)
-	}
+// Reconcile replaces the default reconciler and stores field provenance
+// with the given repository
+func (a *Aggregator) Reconcile(reconciler *reconcile.Reconciler, provenanceRepo *repository.ProvenanceRepository) {
+	a.reconciler = reconciler
+	a.provenanceRepo = provenanceRepo
+}
 
-	// Try primary source: Sofascore
-	matches, err := a.sofascore.GetLiveMatches()
-	if err != nil {
-		log.Printf("Sofascore fetch failed: %v, trying fallback...", err)
+// Forward sends ingested live matches and the events derived from them to
+// the given channels. Sends never block; updates are dropped if a channel is full.
+func (a *Aggregator) Forward(updateChan chan *domain.Match, eventChan chan *domain.MatchEvent) {
+	a.updateChan = updateChan
+	a.eventChan = eventChan
+}
 
-		// Fallback: Get from database
-		matches, err = a.matchRepo.GetAll(ctx, string(domain.StatusLive))
-		if err != nil {
-			return nil, fmt.Errorf("all sources failed: %w", err)
-		}
+// OnFinished calls fn with every match whose stored status changes to
+// finished, live or not. fn must not block.
+func (a *Aggregator) OnFinished(fn func(*domain.Match)) {
+	a.finished = fn
+}
 
-		log.Printf("Using %d cached matches from database", len(matches))
-		return matches, nil
-	}
+// IngestMatches reconciles matches from a provider with what other providers
+// reported and persists the result. Live matches are cached, diffed against
+// the previous poll for typed events and forwarded. Matches that fail to
+// persist are still cached and forwarded, and reported in the error.
+// Matches without a tournament are not stored and count as neither stored
+// nor failed.
+func (a *Aggregator) IngestMatches(ctx context.Context, source string, matches []*domain.Match) (providers.Ingested, error) {
+	log.Printf("Fetched %d matches from %s", len(matches), source)
+
+	now := time.Now()
+	defer a.reconciler.Prune(now.Add(-reconcile.Retention))
 
-	log.Printf("Fetched %d live matches from Sofascore", len(matches))
+	var ingested providers.Ingested
+	var lastErr error
+	for _, observed := range matches {
+		result := a.reconciler.Observe(source, observed, now)
+		match := result.Match
+
+		// Save to database
+		previous, stored, err := a.persistMatch(ctx, match)
+		switch {
+		case err != nil:
+			log.Printf("Failed to persist match %s: %v", match.ID, err)
+			ingested.Failed++
+			lastErr = err
+		case stored:
+			ingested.Stored++
+			if a.provenanceRepo != nil {
+				if err := a.provenanceRepo.Save(ctx, match.ID, result.Provenance); err != nil {
+					log.Printf("Failed to save provenance for %s: %v", match.ID, err)
+				}
+			}
+			if a.finished != nil && match.Status == domain.StatusFinished && previous != domain.StatusFinished {
+				a.finished(match)
+			}
+		}
 
-	// Persist to database
-	for _, match := range matches {
 		// Update cache
+		live := match.Status == domain.StatusLive
 		a.cacheMu.Lock()
-		a.cache[match.ID] = match
+		_, wasLive := a.cache[match.ID]
+		if live {
+			a.cache[match.ID] = match
+		} else {
+			delete(a.cache, match.ID)
+		}
 		a.cacheMu.Unlock()
 
-		// Save to database
-		if err := a.persistMatch(ctx, match); err != nil {
-			log.Printf("Failed to persist match %s: %v", match.ID, err)
+		// A match leaving the live set is forwarded once more so clients
+		// see it finish
+		if live || wasLive {
+			a.forward(match)
+		}
+		if wasLive && !live {
+			a.tracker.Forget(match.ID)
+		}
+	}
+
+	if lastErr != nil {
+		return ingested, fmt.Errorf("failed to persist %d of %d matches from %s: %w", ingested.Failed, len(matches), source, lastErr)
+	}
+	return ingested, nil
+}
+
+// forward sends a live match and its typed events without blocking
+func (a *Aggregator) forward(match *domain.Match) {
+	events := a.tracker.Observe(match)
+
+	if a.updateChan != nil {
+		select {
+		case a.updateChan <- match:
+		default:
+			// Channel full, skip this update
+		}
+	}
+
+	if a.eventChan != nil {
+		for _, ev := range events {
+			ev := ev
+			select {
+			case a.eventChan <- &ev:
+			default:
+				// Channel full, skip this event
+			}
+		}
+	}
+}
+
+// IngestPlayers stores ranked players from a provider. Country codes already
+// on file are kept when the provider does not know them.
+func (a *Aggregator) IngestPlayers(ctx context.Context, source string, players []*domain.Player) (providers.Ingested, error) {
+	updateCount := 0
+	var lastErr error
+	for _, player := range players {
+		if player.CountryCode == "" {
+			player.CountryCode = "XX"
+			if existing, err := a.playerRepo.GetByID(ctx, player.ID); err == nil && existing != nil {
+				player.CountryCode = existing.CountryCode
+			}
+		}
+		if err := a.playerRepo.Create(ctx, player); err != nil {
+			log.Printf("Failed to save player %s: %v", player.Name, err)
+			lastErr = err
+			continue
+		}
+		updateCount++
+	}
+
+	log.Printf("✅ Stored %d player rankings from %s", updateCount, source)
+	ingested := providers.Ingested{Stored: updateCount, Failed: len(players) - updateCount}
+	if lastErr != nil {
+		return ingested, fmt.Errorf("failed to save %d of %d players from %s: %w", ingested.Failed, len(players), source, lastErr)
+	}
+	return ingested, nil
+}
+
+// IngestTournaments merges tournaments from a provider into what is stored
+func (a *Aggregator) IngestTournaments(ctx context.Context, source string, tournaments []*domain.Tournament) (providers.Ingested, error) {
+	updateCount := 0
+	var lastErr error
+	for _, tournament := range tournaments {
+		if err := a.tournamentRepo.Upsert(ctx, tournament); err != nil {
+			log.Printf("Failed to save tournament %s: %v", tournament.Name, err)
+			lastErr = err
+			continue
 		}
+		updateCount++
 	}
 
-	return matches, nil
+	log.Printf("✅ Stored %d tournaments from %s", updateCount, source)
+	ingested := providers.Ingested{Stored: updateCount, Failed: len(tournaments) - updateCount}
+	if lastErr != nil {
+		return ingested, fmt.Errorf("failed to save %d of %d tournaments from %s: %w", ingested.Failed, len(tournaments), source, lastErr)
+	}
+	return ingested, nil
 }
 
-// persistMatch saves match data to the database
-func (a *Aggregator) persistMatch(ctx context.Context, match *domain.Match) error {
-	// Create/update tournament
-	tournament := &domain.Tournament{
-		ID:      match.TournamentID,
-		Name:    match.TournamentID, // We'll enhance this later
-		Surface: "Hard",              // Default, enhance later
-		City:    "Unknown",
+// persistMatch saves match data to the database and returns the status it
+// had before, or "" for a new match, and whether it stored the match
+func (a *Aggregator) persistMatch(ctx context.Context, match *domain.Match) (domain.MatchStatus, bool, error) {
+	// Matches must belong to a tournament to be stored
+	if match.TournamentID == "" {
+		return "", false, nil
+	}
+
+	// Merge whatever the feed knows about the tournament; a bare ID only
+	// creates the row if it is missing
+	tournament := match.Tournament
+	if tournament == nil || tournament.ID != match.TournamentID {
+		tournament = &domain.Tournament{ID: match.TournamentID}
 	}
-	if err := a.tournamentRepo.Create(ctx, tournament); err != nil {
-		return fmt.Errorf("failed to save tournament: %w", err)
+	if err := a.tournamentRepo.Upsert(ctx, tournament); err != nil {
+		return "", false, fmt.Errorf("failed to save tournament: %w", err)
 	}
 
 	// Create/update players
 	if match.Player1 != nil {
 		if err := a.playerRepo.Create(ctx, match.Player1); err != nil {
-			return fmt.Errorf("failed to save player1: %w", err)
+			return "", false, fmt.Errorf("failed to save player1: %w", err)
 		}
 	}
 	if match.Player2 != nil {
 		if err := a.playerRepo.Create(ctx, match.Player2); err != nil {
-			return fmt.Errorf("failed to save player2: %w", err)
+			return "", false, fmt.Errorf("failed to save player2: %w", err)
 		}
 	}
 
@@ -113,44 +249,37 @@ func (a *Aggregator) persistMatch(ctx context.Context, match *domain.Match) erro
 	existing, err := a.matchRepo.GetByID(ctx, match.ID)
 	if err != nil || existing == nil {
 		// Create new match
-		return a.matchRepo.Create(ctx, match)
+		if err := a.matchRepo.Create(ctx, match); err != nil {
+			return "", false, err
+		}
+		return "", true, nil
 	}
 
 	// Update existing match
-	return a.matchRepo.Update(ctx, match)
+	if err := a.matchRepo.Update(ctx, match); err != nil {
+		return existing.Status, false, err
+	}
+	return existing.Status, true, nil
 }
 
-// StartPeriodicFetch runs continuous fetching in the background
-func (a *Aggregator) StartPeriodicFetch(ctx context.Context, updateChan chan *domain.Match, interval time.Duration) {
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
-	log.Printf("Starting periodic fetch every %v", interval)
+// Observations returns each provider's latest report of a match and the
+// provenance of its reconciled fields
+func (a *Aggregator) Observations(matchID string) ([]reconcile.Observation, []domain.FieldProvenance) {
+	return a.reconciler.Observations(matchID)
+}
 
-	for {
-		select {
-		case <-ctx.Done():
-			log.Println("Stopping periodic fetch")
-			return
-		case <-ticker.C:
-			matches, err := a.FetchLiveMatches(ctx)
-			if err != nil {
-				log.Printf("Periodic fetch error: %v", err)
-				continue
-			}
+// LiveMatches returns the cached live matches from the latest polls
+func (a *Aggregator) LiveMatches() []*domain.Match {
+	a.cacheMu.RLock()
+	defer a.cacheMu.RUnlock()
 
-			// Send updates via channel
-			for _, match := range matches {
-				select {
-				case updateChan <- match:
-				case <-ctx.Done():
-					return
-				default:
-					// Channel full, skip this update
-				}
-			}
+	matches := make([]*domain.Match, 0, len(a.cache))
+	for _, match := range a.cache {
+		if match.Status == domain.StatusLive {
+			matches = append(matches, match)
 		}
 	}
+	return matches
 }
 
 // GetCachedMatch retrieves a match from cache if available
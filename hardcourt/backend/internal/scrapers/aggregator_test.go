@@ -1,10 +1,12 @@
 package scrapers
 
 import (
+	"context"
 	"testing"
 	"time"
 
-	"golang.org/x/time/rate"
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/providers"
 )
 
 func TestAggregator_Creation(t *testing.T) {
@@ -15,14 +17,6 @@ func TestAggregator_Creation(t *testing.T) {
 		t.Fatal("Expected aggregator to be created, got nil")
 	}
 
-	if agg.sofascore == nil {
-		t.Error("Expected Sofascore client to be initialized")
-	}
-
-	if agg.limiter == nil {
-		t.Error("Expected rate limiter to be initialized")
-	}
-
 	if agg.cache == nil {
 		t.Error("Expected cache to be initialized")
 	}
@@ -50,15 +44,41 @@ func TestAggregator_CacheOperations(t *testing.T) {
 	}
 }
 
-func TestAggregator_RateLimiting(t *testing.T) {
+func TestAggregator_ForwardsLiveMatches(t *testing.T) {
 	agg := NewAggregator(nil, nil, nil)
+	updates := make(chan *domain.Match, 10)
+	events := make(chan *domain.MatchEvent, 10)
+	agg.Forward(updates, events)
+
+	// Matches without a tournament are not persisted, so nil repos are fine
+	// and neither match counts as stored
+	live := &domain.Match{ID: "m1", Status: domain.StatusLive}
+	if ingested, err := agg.IngestMatches(context.Background(), "test", []*domain.Match{live, {ID: "m2", Status: domain.StatusScheduled}}); err != nil || ingested != (providers.Ingested{}) {
+		t.Fatalf("Expected no matches stored or failed, got %+v: %v", ingested, err)
+	}
 
-	// Limiter should be configured for 1 request per 2 seconds
-	if agg.limiter.Limit() != rate.Every(2*time.Second) {
-		t.Errorf("Expected rate limit of 1 per 2s, got %v", agg.limiter.Limit())
+	if len(updates) != 1 || len(agg.LiveMatches()) != 1 {
+		t.Fatalf("Expected only the live match to be forwarded and cached, got %d updates", len(updates))
 	}
+	<-updates
 
-	if agg.limiter.Burst() != 1 {
-		t.Errorf("Expected burst of 1, got %d", agg.limiter.Burst())
+	finished := &domain.Match{ID: "m1", Status: domain.StatusFinished}
+	agg.IngestMatches(context.Background(), "test", []*domain.Match{finished})
+
+	if len(updates) != 1 {
+		t.Errorf("Expected the finished match to be forwarded once more, got %d updates", len(updates))
+	}
+	if _, ok := agg.GetCachedMatch("m1"); ok {
+		t.Error("Expected finished match to leave the live cache")
+	}
+
+	var sawStatusChange bool
+	for len(events) > 0 {
+		if ev := <-events; ev.Type == domain.EventStatusChanged {
+			sawStatusChange = true
+		}
+	}
+	if !sawStatusChange {
+		t.Error("Expected a status change event when the match finished")
 	}
 }
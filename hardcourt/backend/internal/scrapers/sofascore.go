@@ -1,29 +1,67 @@
 package scrapers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/providers"
+	"hardcourt/backend/internal/schedule"
+	"hardcourt/backend/internal/scoring"
+
+	"golang.org/x/time/rate"
 )
 
 const (
 	sofascoreBaseURL = "https://api.sofascore.com/api/v1"
 	userAgent        = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36"
+
+	// Live match statistics are refreshed at most this often
+	statsRefresh = 2 * time.Minute
+
+	// Statistics requests per live poll, so a busy day stays within the rate limit
+	maxStatsPerPoll = 5
 )
 
 type SofascoreClient struct {
 	httpClient *http.Client
+	baseURL    string
+	limiter    *rate.Limiter
+
+	// Statistics of live events, keyed by event ID
+	statsMu sync.Mutex
+	stats   map[int]cachedStats
+}
+
+type cachedStats struct {
+	stats     domain.MatchStats
+	fetchedAt time.Time
 }
 
-func NewSofascoreClient() *SofascoreClient {
+var _ providers.Provider = (*SofascoreClient)(nil)
+
+// NewSofascoreClient creates a client for the Sofascore API. Options
+// override the base URL and HTTP client, e.g. for tests.
+func NewSofascoreClient(opts ...providers.Option) *SofascoreClient {
+	config := providers.ApplyOptions(providers.HTTPConfig{
+		BaseURL: sofascoreBaseURL,
+		Client:  &http.Client{Timeout: 10 * time.Second},
+	}, opts)
+
 	return &SofascoreClient{
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		httpClient: config.Client,
+		baseURL:    config.BaseURL,
+		limiter:    rate.NewLimiter(rate.Every(2*time.Second), 1), // 1 request every 2 seconds
+		stats:      make(map[int]cachedStats),
 	}
 }
 
@@ -33,29 +71,84 @@ type sofascoreResponse struct {
 }
 
 type sofascoreEvent struct {
-	ID               int                    `json:"id"`
-	Tournament       sofascoreTournament    `json:"tournament"`
-	HomeTeam         sofascorePlayer        `json:"homeTeam"`
-	AwayTeam         sofascorePlayer        `json:"awayTeam"`
-	Status           sofascoreStatus        `json:"status"`
-	HomeScore        sofascoreScore         `json:"homeScore"`
-	AwayScore        sofascoreScore         `json:"awayScore"`
-	StartTimestamp   int64                  `json:"startTimestamp"`
-	WinnerCode       int                    `json:"winnerCode,omitempty"`
+	ID             int                 `json:"id"`
+	Tournament     sofascoreTournament `json:"tournament"`
+	HomeTeam       sofascorePlayer     `json:"homeTeam"`
+	AwayTeam       sofascorePlayer     `json:"awayTeam"`
+	Status         sofascoreStatus     `json:"status"`
+	HomeScore      sofascoreScore      `json:"homeScore"`
+	AwayScore      sofascoreScore      `json:"awayScore"`
+	StartTimestamp int64               `json:"startTimestamp"`
+	WinnerCode     int                 `json:"winnerCode,omitempty"`
+	FirstToServe   int                 `json:"firstToServe,omitempty"` // 1 home, 2 away
+	Season         *sofascoreSeason    `json:"season,omitempty"`
+	RoundInfo      *sofascoreRound     `json:"roundInfo,omitempty"`
+	GroundType     string              `json:"groundType,omitempty"`
+	Venue          *sofascoreVenue     `json:"venue,omitempty"`
 }
 
 type sofascoreTournament struct {
-	Name    string `json:"name"`
-	Surface string `json:"groundType,omitempty"`
-	City    string `json:"city,omitempty"`
+	Name             string                     `json:"name"` // e.g. "Australian Open, Men Singles"
+	Surface          string                     `json:"groundType,omitempty"`
+	City             string                     `json:"city,omitempty"`
+	Category         sofascoreCategory          `json:"category"`
+	UniqueTournament *sofascoreUniqueTournament `json:"uniqueTournament,omitempty"`
+}
+
+// sofascoreUniqueTournament is the tournament across all its editions
+type sofascoreUniqueTournament struct {
+	ID           int               `json:"id"`
+	Name         string            `json:"name"`
+	GroundType   string            `json:"groundType,omitempty"`
+	TennisPoints int               `json:"tennisPoints,omitempty"`
+	Category     sofascoreCategory `json:"category"`
+}
+
+type sofascoreCategory struct {
+	Name string `json:"name"` // ATP, WTA, Challenger, ...
+}
+
+// sofascoreSeason is one edition of a unique tournament
+type sofascoreSeason struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Year string `json:"year"`
+}
+
+type sofascoreRound struct {
+	Round int    `json:"round"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sofascoreVenue struct {
+	City    sofascoreName `json:"city"`
+	Country sofascoreName `json:"country"`
+	Stadium sofascoreName `json:"stadium"` // The court, e.g. "Rod Laver Arena"
+}
+
+type sofascoreName struct {
+	Name string `json:"name"`
 }
 
 type sofascorePlayer struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	Country     string `json:"country,omitempty"`
-	CountryCode string `json:"countryCode,omitempty"`
-	Ranking     int    `json:"ranking,omitempty"`
+	ID          int              `json:"id"`
+	Name        string           `json:"name"`
+	Country     sofascoreCountry `json:"country"`
+	CountryCode string           `json:"countryCode,omitempty"`
+	Ranking     int              `json:"ranking,omitempty"`
+}
+
+type sofascoreCountry struct {
+	Alpha2 string `json:"alpha2,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+// countryCode returns the player's two-letter country code
+func (p sofascorePlayer) countryCode() string {
+	if p.CountryCode != "" {
+		return p.CountryCode
+	}
+	return p.Country.Alpha2
 }
 
 type sofascoreStatus struct {
@@ -65,22 +158,76 @@ type sofascoreStatus struct {
 }
 
 type sofascoreScore struct {
-	Current int   `json:"current"` // Sets won
-	Period1 int   `json:"period1,omitempty"`
-	Period2 int   `json:"period2,omitempty"`
-	Period3 int   `json:"period3,omitempty"`
-	Period4 int   `json:"period4,omitempty"`
-	Period5 int   `json:"period5,omitempty"`
-	Display int   `json:"display,omitempty"` // Current game score
+	Current         int    `json:"current"` // Sets won
+	Display         int    `json:"display,omitempty"`
+	Period1         int    `json:"period1,omitempty"` // Games per set
+	Period2         int    `json:"period2,omitempty"`
+	Period3         int    `json:"period3,omitempty"`
+	Period4         int    `json:"period4,omitempty"`
+	Period5         int    `json:"period5,omitempty"`
+	Period1TieBreak int    `json:"period1TieBreak,omitempty"`
+	Period2TieBreak int    `json:"period2TieBreak,omitempty"`
+	Period3TieBreak int    `json:"period3TieBreak,omitempty"`
+	Period4TieBreak int    `json:"period4TieBreak,omitempty"`
+	Period5TieBreak int    `json:"period5TieBreak,omitempty"`
+	Point           string `json:"point,omitempty"` // Current game: "0", "15", "30", "40", "A", or tiebreak points
+}
+
+// games returns the games won in set n (1-based)
+func (s sofascoreScore) games(n int) int {
+	return [...]int{0, s.Period1, s.Period2, s.Period3, s.Period4, s.Period5}[n]
+}
+
+// tiebreak returns the tiebreak points won in set n (1-based)
+func (s sofascoreScore) tiebreak(n int) int {
+	return [...]int{0, s.Period1TieBreak, s.Period2TieBreak, s.Period3TieBreak, s.Period4TieBreak, s.Period5TieBreak}[n]
+}
+
+// Statistics response from /event/{id}/statistics
+type sofascoreStatisticsResponse struct {
+	Statistics []sofascoreStatisticsPeriod `json:"statistics"`
+}
+
+type sofascoreStatisticsPeriod struct {
+	Period string `json:"period"` // "ALL", "1ST", "2ND", ...
+	Groups []struct {
+		GroupName       string              `json:"groupName"`
+		StatisticsItems []sofascoreStatItem `json:"statisticsItems"`
+	} `json:"groups"`
+}
+
+type sofascoreStatItem struct {
+	Name      string  `json:"name"`
+	Key       string  `json:"key,omitempty"`
+	HomeValue float64 `json:"homeValue"`
+	AwayValue float64 `json:"awayValue"`
+	HomeTotal float64 `json:"homeTotal,omitempty"` // Attempts, for ratio stats
+	AwayTotal float64 `json:"awayTotal,omitempty"`
 }
 
-// GetLiveMatches fetches currently live tennis matches
-func (s *SofascoreClient) GetLiveMatches() ([]*domain.Match, error) {
-	url := fmt.Sprintf("%s/sport/tennis/scheduled-events/%s", sofascoreBaseURL, time.Now().Format("2006-01-02"))
+// Name identifies Sofascore in provider config
+func (s *SofascoreClient) Name() string { return "sofascore" }
+
+// fetchEvents returns every tennis event scheduled on day
+func (s *SofascoreClient) fetchEvents(ctx context.Context, day time.Time) ([]sofascoreEvent, error) {
+	url := fmt.Sprintf("%s/sport/tennis/scheduled-events/%s", s.baseURL, day.Format("2006-01-02"))
+
+	var apiResp sofascoreResponse
+	if err := s.get(ctx, url, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to fetch events: %w", err)
+	}
+	return apiResp.Events, nil
+}
 
-	req, err := http.NewRequest("GET", url, nil)
+// get fetches a Sofascore API URL into out, respecting the rate limit
+func (s *SofascoreClient) get(ctx context.Context, url string, out interface{}) error {
+	if err := s.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("rate limit exceeded: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("User-Agent", userAgent)
@@ -88,117 +235,442 @@ func (s *SofascoreClient) GetLiveMatches() ([]*domain.Match, error) {
 
 	resp, err := s.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch live matches: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("sofascore API returned %d: %s", resp.StatusCode, string(body))
+		return fmt.Errorf("sofascore API returned %d: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
 	}
+	return nil
+}
+
+// LiveMatches fetches currently live tennis matches
+func (s *SofascoreClient) LiveMatches(ctx context.Context) ([]*domain.Match, error) {
+	url := fmt.Sprintf("%s/sport/tennis/events/live", s.baseURL)
 
 	var apiResp sofascoreResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := s.get(ctx, url, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to fetch live matches: %w", err)
+	}
+
+	var live []sofascoreEvent
+	for _, event := range apiResp.Events {
+		if convertStatus(event.Status) == domain.StatusLive {
+			live = append(live, event)
+		}
 	}
 
-	return s.convertToMatches(apiResp.Events), nil
+	matches := s.convertToMatches(live)
+	s.attachStats(ctx, live, matches)
+	return matches, nil
 }
 
-// convertToMatches converts Sofascore events to our domain Match model
-func (s *SofascoreClient) convertToMatches(events []sofascoreEvent) []*domain.Match {
-	matches := make([]*domain.Match, 0, len(events))
+// attachStats fills in statistics for live matches, refreshing the stalest
+// few each poll. A failed statistics request keeps the previous numbers.
+func (s *SofascoreClient) attachStats(ctx context.Context, events []sofascoreEvent, matches []*domain.Match) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
 
+	// Forget events that are no longer live
+	live := make(map[int]bool, len(events))
 	for _, event := range events {
-		// Only include live matches (status code 6 is "inprogress")
-		if event.Status.Code != 6 {
-			continue
+		live[event.ID] = true
+	}
+	for id := range s.stats {
+		if !live[id] {
+			delete(s.stats, id)
 		}
+	}
 
-		match := &domain.Match{
-			ID:           fmt.Sprintf("sofa_%d", event.ID),
-			TournamentID: fmt.Sprintf("t_%s", event.Tournament.Name),
-			Player1ID:    fmt.Sprintf("p_%d", event.HomeTeam.ID),
-			Player2ID:    fmt.Sprintf("p_%d", event.AwayTeam.ID),
-			Status:       domain.StatusLive,
-			StartTime:    time.Unix(event.StartTimestamp, 0),
-			Player1: &domain.Player{
-				ID:          fmt.Sprintf("p_%d", event.HomeTeam.ID),
-				Name:        event.HomeTeam.Name,
-				CountryCode: event.HomeTeam.CountryCode,
-				Rank:        event.HomeTeam.Ranking,
-			},
-			Player2: &domain.Player{
-				ID:          fmt.Sprintf("p_%d", event.AwayTeam.ID),
-				Name:        event.AwayTeam.Name,
-				CountryCode: event.AwayTeam.CountryCode,
-				Rank:        event.AwayTeam.Ranking,
-			},
-			Score: domain.ScoreState{
-				SetsP1:   event.HomeScore.Current,
-				SetsP2:   event.AwayScore.Current,
-				GamesP1:  event.HomeScore.Display,
-				GamesP2:  event.AwayScore.Display,
-				PointsP1: "0", // Sofascore doesn't provide point-by-point
-				PointsP2: "0",
-				Serving:  1, // Default
-			},
-			Stats:     domain.MatchStats{},
-			WinProbP1: 0.5, // Calculate separately if needed
-		}
-
-		// Determine winner if match is finished
-		if event.Status.Code == 7 { // Finished
-			match.Status = domain.StatusFinished
-			if event.WinnerCode == 1 {
-				winnerID := match.Player1ID
-				match.WinnerID = &winnerID
-			} else if event.WinnerCode == 2 {
-				winnerID := match.Player2ID
-				match.WinnerID = &winnerID
+	// Stalest first, never-fetched events before everything else
+	order := make([]int, len(events))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return s.stats[events[order[a]].ID].fetchedAt.Before(s.stats[events[order[b]].ID].fetchedAt)
+	})
+
+	fetched := 0
+	for _, i := range order {
+		id := events[i].ID
+		cached, ok := s.stats[id]
+		if (!ok || time.Since(cached.fetchedAt) >= statsRefresh) && fetched < maxStatsPerPoll {
+			fetched++
+			stats, err := s.fetchStatistics(ctx, id)
+			if err != nil {
+				log.Printf("Sofascore statistics for event %d unavailable: %v", id, err)
+			} else {
+				cached = cachedStats{stats: stats, fetchedAt: time.Now()}
+				s.stats[id] = cached
 			}
 		}
+		matches[i].Stats = cached.stats
+	}
+}
+
+// ScheduledMatches fetches every match on day, whatever its status
+func (s *SofascoreClient) ScheduledMatches(ctx context.Context, day time.Time) ([]*domain.Match, error) {
+	events, err := s.fetchEvents(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*domain.Match, 0, len(events))
+	for _, event := range events {
+		matches = append(matches, s.convertEvent(event))
+	}
+	// The feed has courts and start times but no explicit order
+	schedule.Order(matches)
+	return matches, nil
+}
+
+// Rankings is not polled from Sofascore
+func (s *SofascoreClient) Rankings(ctx context.Context) ([]*domain.Player, error) {
+	return nil, providers.ErrUnsupported
+}
+
+// Tournaments is not polled from Sofascore; tournaments arrive with matches
+func (s *SofascoreClient) Tournaments(ctx context.Context) ([]*domain.Tournament, error) {
+	return nil, providers.ErrUnsupported
+}
 
+// convertToMatches converts Sofascore events to our domain Match model,
+// keeping only live matches
+func (s *SofascoreClient) convertToMatches(events []sofascoreEvent) []*domain.Match {
+	matches := make([]*domain.Match, 0, len(events))
+
+	for _, event := range events {
+		match := s.convertEvent(event)
+		if match.Status != domain.StatusLive {
+			continue
+		}
 		matches = append(matches, match)
 	}
 
 	return matches
 }
 
-// GetMatchDetails fetches detailed stats for a specific match
-func (s *SofascoreClient) GetMatchDetails(matchID int) (*domain.Match, error) {
-	url := fmt.Sprintf("%s/event/%d", sofascoreBaseURL, matchID)
+// convertEvent converts one Sofascore event to our domain Match model
+func (s *SofascoreClient) convertEvent(event sofascoreEvent) *domain.Match {
+	match := &domain.Match{
+		ID:        fmt.Sprintf("sofa_%d", event.ID),
+		Player1ID: fmt.Sprintf("p_%d", event.HomeTeam.ID),
+		Player2ID: fmt.Sprintf("p_%d", event.AwayTeam.ID),
+		Status:    convertStatus(event.Status),
+		StartTime: time.Unix(event.StartTimestamp, 0).UTC(),
+		Player1: &domain.Player{
+			ID:          fmt.Sprintf("p_%d", event.HomeTeam.ID),
+			Name:        event.HomeTeam.Name,
+			CountryCode: event.HomeTeam.countryCode(),
+			Rank:        event.HomeTeam.Ranking,
+		},
+		Player2: &domain.Player{
+			ID:          fmt.Sprintf("p_%d", event.AwayTeam.ID),
+			Name:        event.AwayTeam.Name,
+			CountryCode: event.AwayTeam.countryCode(),
+			Rank:        event.AwayTeam.Ranking,
+		},
+		Stats:     domain.MatchStats{},
+		WinProbP1: 0.5, // Calculate separately if needed
+	}
+	match.Score, match.Sets = convertScore(event, match.Status)
+	match.Tournament = convertTournament(event)
+	match.TournamentID = match.Tournament.ID
+	if event.RoundInfo != nil {
+		match.Round = providers.Round(event.RoundInfo.Name)
+	}
+	if event.Venue != nil {
+		match.Court = event.Venue.Stadium.Name
+	}
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	// Determine winner if match is finished
+	if match.Status == domain.StatusFinished {
+		if event.WinnerCode == 1 {
+			winnerID := match.Player1ID
+			match.WinnerID = &winnerID
+		} else if event.WinnerCode == 2 {
+			winnerID := match.Player2ID
+			match.WinnerID = &winnerID
+		}
 	}
 
-	req.Header.Set("User-Agent", userAgent)
-	req.Header.Set("Accept", "application/json")
+	return match
+}
 
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch match details: %w", err)
+// otherDraw returns the draw of an event outside the ATP men's singles, such
+// as "Women Singles" or "Men Doubles", or "" for men's singles. The feed
+// names the draw after the tournament, "Australian Open, Women Singles", and
+// the tour as the category.
+func otherDraw(event sofascoreEvent) string {
+	_, draw, _ := strings.Cut(event.Tournament.Name, ",")
+	draw = strings.TrimSpace(draw)
+	lower := strings.ToLower(draw)
+	for _, other := range []string{"women", "doubles", "mixed", "girls", "boys", "wheelchair"} {
+		if strings.Contains(lower, other) {
+			return draw
+		}
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("sofascore API returned %d", resp.StatusCode)
+	tour := event.Tournament.Category.Name
+	if ut := event.Tournament.UniqueTournament; ut != nil && ut.Category.Name != "" {
+		tour = ut.Category.Name
+	}
+	if strings.EqualFold(tour, "WTA") {
+		if draw == "" {
+			return tour
+		}
+		return draw
 	}
+	return ""
+}
 
-	var apiResp struct {
-		Event sofascoreEvent `json:"event"`
+// convertTournament resolves the canonical tournament an event belongs to.
+// Events from other draws of the same tournament, such as the women's
+// singles, get a tournament of their own so they never join the men's.
+// Fields the feed does not provide are left empty rather than guessed.
+func convertTournament(event sofascoreEvent) *domain.Tournament {
+	name := event.Tournament.Name
+	category := event.Tournament.Category
+	points := 0
+	groundType := event.GroundType
+	tournament := &domain.Tournament{Source: "sofascore"}
+
+	if ut := event.Tournament.UniqueTournament; ut != nil {
+		name = ut.Name
+		points = ut.TennisPoints
+		if ut.Category.Name != "" {
+			category = ut.Category
+		}
+		if groundType == "" {
+			groundType = ut.GroundType
+		}
+		if ut.ID != 0 {
+			tournament.ExternalID = strconv.Itoa(ut.ID)
+		}
+	} else if i := strings.Index(name, ","); i > 0 {
+		// "Australian Open, Men Singles" names the draw, not the tournament
+		name = name[:i]
+	}
+	if groundType == "" {
+		groundType = event.Tournament.Surface
+	}
+
+	year := time.Unix(event.StartTimestamp, 0).UTC().Year()
+	if event.Season != nil {
+		if y, err := strconv.Atoi(event.Season.Year); err == nil {
+			year = y
+		}
+		if event.Season.ID != 0 {
+			tournament.SeasonID = strconv.Itoa(event.Season.ID)
+		}
+	}
+
+	tournament.ID = providers.TournamentID(name, year)
+	tournament.Name = name
+	if draw := otherDraw(event); draw != "" {
+		tournament.ID += "-" + strings.Join(strings.Fields(strings.ToLower(draw)), "-")
+		tournament.Name += ", " + draw
+	}
+	tournament.Year = year
+	tournament.Surface = providers.Surface(groundType)
+	tournament.Category = providers.Category(points, category.Name)
+	tournament.City = event.Tournament.City
+	if event.Venue != nil {
+		if event.Venue.City.Name != "" {
+			tournament.City = event.Venue.City.Name
+		}
+		tournament.Country = event.Venue.Country.Name
+	}
+	return tournament
+}
+
+// convertScore maps period scores to completed sets and the state of the
+// current set. The server is derived from who served first, since the feed
+// does not say who is serving now.
+func convertScore(event sofascoreEvent, status domain.MatchStatus) (domain.ScoreState, []domain.SetScore) {
+	home, away := event.HomeScore, event.AwayScore
+	score := domain.ScoreState{
+		SetsP1:   home.Current,
+		SetsP2:   away.Current,
+		PointsP1: "0",
+		PointsP2: "0",
+		Serving:  1,
+	}
+	if status == domain.StatusScheduled {
+		return score, nil
+	}
+
+	var sets []domain.SetScore
+	completed := home.Current + away.Current
+	gamesPlayed := 0
+	for n := 1; n <= 5 && n <= completed; n++ {
+		set := domain.SetScore{
+			SetNumber:  n,
+			GamesP1:    home.games(n),
+			GamesP2:    away.games(n),
+			TiebreakP1: home.tiebreak(n),
+			TiebreakP2: away.tiebreak(n),
+		}
+		sets = append(sets, set)
+		gamesPlayed += set.GamesP1 + set.GamesP2
+	}
+
+	// A retirement ends the match mid-set; the set stays in the score
+	if event.Status.Type == "retired" && completed < 5 {
+		n := completed + 1
+		if games := home.games(n) + away.games(n); games > 0 {
+			sets = append(sets, domain.SetScore{
+				SetNumber:  n,
+				GamesP1:    home.games(n),
+				GamesP2:    away.games(n),
+				TiebreakP1: home.tiebreak(n),
+				TiebreakP2: away.tiebreak(n),
+			})
+		}
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if status != domain.StatusLive || completed >= 5 {
+		return score, sets
 	}
 
-	matches := s.convertToMatches([]sofascoreEvent{apiResp.Event})
-	if len(matches) == 0 {
-		return nil, fmt.Errorf("no match found")
+	current := completed + 1
+	score.GamesP1, score.GamesP2 = home.games(current), away.games(current)
+	score.PointsP1, score.PointsP2 = convertPoint(home.Point), convertPoint(away.Point)
+	gamesPlayed += score.GamesP1 + score.GamesP2
+
+	if event.FirstToServe == 1 || event.FirstToServe == 2 {
+		tiebreakPoints := 0
+		if scoring.InTiebreak(score) {
+			tiebreakPoints = scoring.PointValue(score.PointsP1, true) + scoring.PointValue(score.PointsP2, true)
+		}
+		score.Serving = scoring.Server(event.FirstToServe, gamesPlayed, tiebreakPoints)
+	}
+
+	return score, sets
+}
+
+// convertPoint normalises Sofascore's point score to ours
+func convertPoint(point string) string {
+	switch point {
+	case "":
+		return "0"
+	case "A":
+		return "AD"
+	}
+	return point
+}
+
+// convertStatus maps Sofascore's status to ours. The type is authoritative;
+// older payloads only carry the code (6 in progress, 7 finished).
+func convertStatus(status sofascoreStatus) domain.MatchStatus {
+	switch status.Type {
+	case "inprogress":
+		return domain.StatusLive
+	case "finished", "retired":
+		return domain.StatusFinished
+	case "notstarted":
+		return domain.StatusScheduled
+	}
+	switch status.Code {
+	case 6:
+		return domain.StatusLive
+	case 7:
+		return domain.StatusFinished
+	}
+	return domain.StatusScheduled
+}
+
+// fetchStatistics returns whole-match statistics for an event
+func (s *SofascoreClient) fetchStatistics(ctx context.Context, eventID int) (domain.MatchStats, error) {
+	url := fmt.Sprintf("%s/event/%d/statistics", s.baseURL, eventID)
+
+	var apiResp sofascoreStatisticsResponse
+	if err := s.get(ctx, url, &apiResp); err != nil {
+		return domain.MatchStats{}, fmt.Errorf("failed to fetch statistics: %w", err)
+	}
+	return convertStatistics(apiResp.Statistics), nil
+}
+
+// convertStatistics maps the "ALL" period statistics onto MatchStats.
+// Items are matched by key, falling back to the display name.
+func convertStatistics(periods []sofascoreStatisticsPeriod) domain.MatchStats {
+	var stats domain.MatchStats
+	for _, period := range periods {
+		if period.Period != "ALL" {
+			continue
+		}
+		for _, group := range period.Groups {
+			for _, item := range group.StatisticsItems {
+				home, away := int(item.HomeValue), int(item.AwayValue)
+				switch statKey(item) {
+				case "aces":
+					stats.AcesP1, stats.AcesP2 = home, away
+				case "doublefaults":
+					stats.DoubleFaultsP1, stats.DoubleFaultsP2 = home, away
+				case "breakpointsscored", "breakpointsconverted":
+					stats.BreakPointsP1, stats.BreakPointsP2 = home, away
+				case "winners":
+					stats.WinnersP1, stats.WinnersP2 = home, away
+				case "unforcederrors":
+					stats.UnforcedErrorsP1, stats.UnforcedErrorsP2 = home, away
+				case "firstserveaccuracy", "firstserve":
+					stats.FirstServePctP1 = percentage(item.HomeValue, item.HomeTotal)
+					stats.FirstServePctP2 = percentage(item.AwayValue, item.AwayTotal)
+				}
+			}
+		}
+	}
+	return stats
+}
+
+// statKey normalises an item's key or name, e.g. "Double faults" -> "doublefaults"
+func statKey(item sofascoreStatItem) string {
+	key := item.Key
+	if key == "" {
+		key = item.Name
+	}
+	return strings.ToLower(strings.ReplaceAll(key, " ", ""))
+}
+
+func percentage(value, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return value / total * 100
+}
+
+// MatchDetail fetches a single match by its "sofa_<id>" match ID
+func (s *SofascoreClient) MatchDetail(ctx context.Context, matchID string) (*domain.Match, error) {
+	eventID, err := strconv.Atoi(strings.TrimPrefix(matchID, "sofa_"))
+	if err != nil {
+		return nil, fmt.Errorf("not a sofascore match ID: %s", matchID)
+	}
+
+	url := fmt.Sprintf("%s/event/%d", s.baseURL, eventID)
+
+	var apiResp struct {
+		Event sofascoreEvent `json:"event"`
+	}
+	if err := s.get(ctx, url, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to fetch match details: %w", err)
 	}
 
-	return matches[0], nil
+	// Many matches have no statistics; the result is kept without them
+	match := s.convertEvent(apiResp.Event)
+	if match.Status != domain.StatusScheduled {
+		stats, err := s.fetchStatistics(ctx, eventID)
+		if err != nil {
+			log.Printf("Sofascore statistics for event %d unavailable: %v", eventID, err)
+		} else {
+			match.Stats = stats
+		}
+	}
+	return match, nil
 }
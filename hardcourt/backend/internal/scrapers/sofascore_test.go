@@ -1,7 +1,16 @@
 package scrapers
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/providers"
+	"hardcourt/backend/internal/testutil"
+
+	"golang.org/x/time/rate"
 )
 
 func TestSofascoreClient_Creation(t *testing.T) {
@@ -16,35 +25,76 @@ func TestSofascoreClient_Creation(t *testing.T) {
 	}
 }
 
-func TestSofascoreClient_GetLiveMatches(t *testing.T) {
-	client := NewSofascoreClient()
+// newFixtureClient returns a client reading recorded responses from
+// testdata/sofascore, without the production rate limit
+func newFixtureClient(t *testing.T) *SofascoreClient {
+	t.Helper()
+	server := testutil.FixtureServer(t, map[string]string{
+		"/sport/tennis/events/live":                 "sofascore/live.json",
+		"/sport/tennis/scheduled-events/2025-01-26": "sofascore/scheduled.json",
+		"/event/12961640/statistics":                "sofascore/statistics_12961640.json",
+		"/event/12961700":                           "sofascore/event_12961700.json",
+		"/event/12961700/statistics":                "sofascore/statistics_12961700.json",
+	})
+
+	client := NewSofascoreClient(providers.WithBaseURL(server.URL), providers.WithHTTPClient(server.Client()))
+	client.limiter = rate.NewLimiter(rate.Inf, 1)
+	return client
+}
+
+func TestSofascoreClient_LiveMatches(t *testing.T) {
+	client := newFixtureClient(t)
+
+	matches, err := client.LiveMatches(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to fetch live matches: %v", err)
+	}
+
+	// The finished match in the feed is dropped
+	if len(matches) != 1 {
+		t.Fatalf("Expected 1 live match, got %d", len(matches))
+	}
+	testutil.Golden(t, "sofascore_live", matches)
+}
+
+func TestSofascoreClient_ScheduledMatches(t *testing.T) {
+	client := newFixtureClient(t)
+
+	matches, err := client.ScheduledMatches(context.Background(), time.Date(2025, 1, 26, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Failed to fetch scheduled matches: %v", err)
+	}
+	testutil.Golden(t, "sofascore_scheduled", matches)
+}
+
+func TestSofascoreClient_MatchDetail(t *testing.T) {
+	client := newFixtureClient(t)
+
+	match, err := client.MatchDetail(context.Background(), "sofa_12961700")
+	if err != nil {
+		t.Fatalf("Failed to fetch match detail: %v", err)
+	}
+	testutil.Golden(t, "sofascore_detail", match)
+
+	if _, err := client.MatchDetail(context.Background(), "sofa_1"); err == nil {
+		t.Error("Expected an error for an event the feed does not know")
+	}
+}
 
-	// Note: This is a real API call - may fail if no live matches
-	matches, err := client.GetLiveMatches()
+func TestSofascoreClient_MatchDetailWithoutStatistics(t *testing.T) {
+	server := testutil.FixtureServer(t, map[string]string{
+		"/event/12961700": "sofascore/event_12961700.json",
+	})
+	client := NewSofascoreClient(providers.WithBaseURL(server.URL), providers.WithHTTPClient(server.Client()))
+	client.limiter = rate.NewLimiter(rate.Inf, 1)
 
-	// We don't fail if there are no matches, just check the call works
+	// The statistics endpoint 404s; the result is still returned
+	match, err := client.MatchDetail(context.Background(), "sofa_12961700")
 	if err != nil {
-		t.Logf("API call failed (expected if no live matches): %v", err)
-		// Don't fail test - API might be down or no live matches
-		return
-	}
-
-	t.Logf("Fetched %d live matches", len(matches))
-
-	// If we got matches, validate structure
-	for i, match := range matches {
-		if match.ID == "" {
-			t.Errorf("Match %d has empty ID", i)
-		}
-		if match.Player1 == nil || match.Player2 == nil {
-			t.Errorf("Match %d missing player data", i)
-		}
-		if match.Player1 != nil && match.Player1.Name == "" {
-			t.Errorf("Match %d Player1 has empty name", i)
-		}
-		if match.Player2 != nil && match.Player2.Name == "" {
-			t.Errorf("Match %d Player2 has empty name", i)
-		}
+		t.Fatalf("Expected the match without statistics, got %v", err)
+	}
+	if match.Status != domain.StatusFinished || match.WinnerID == nil || match.Stats != (domain.MatchStats{}) {
+		t.Errorf("Expected a finished match with a winner and empty stats, got %+v", match)
 	}
 }
 
@@ -80,11 +130,15 @@ func TestSofascoreClient_ConvertToMatches(t *testing.T) {
 		},
 		HomeScore: sofascoreScore{
 			Current: 1,
-			Display: 3,
+			Period1: 6,
+			Period2: 4,
+			Period3: 3,
 		},
 		AwayScore: sofascoreScore{
 			Current: 1,
-			Display: 4,
+			Period1: 4,
+			Period2: 6,
+			Period3: 4,
 		},
 	}
 
@@ -111,4 +165,211 @@ func TestSofascoreClient_ConvertToMatches(t *testing.T) {
 	if match.Score.GamesP1 != 3 {
 		t.Errorf("Expected GamesP1 = 3, got %d", match.Score.GamesP1)
 	}
+
+	if len(match.Sets) != 2 {
+		t.Errorf("Expected 2 completed sets, got %d", len(match.Sets))
+	}
+}
+
+func TestConvertScore_TiebreaksPointsAndServer(t *testing.T) {
+	event := sofascoreEvent{
+		Status:       sofascoreStatus{Type: "inprogress"},
+		FirstToServe: 2,
+		HomeScore: sofascoreScore{
+			Current: 1, Period1: 7, Period1TieBreak: 7, Period2: 2, Point: "A",
+		},
+		AwayScore: sofascoreScore{
+			Current: 0, Period1: 6, Period1TieBreak: 5, Period2: 3, Point: "40",
+		},
+	}
+
+	score, sets := convertScore(event, domain.StatusLive)
+
+	if len(sets) != 1 || sets[0] != (domain.SetScore{SetNumber: 1, GamesP1: 7, GamesP2: 6, TiebreakP1: 7, TiebreakP2: 5}) {
+		t.Errorf("Unexpected sets: %+v", sets)
+	}
+	if score.GamesP1 != 2 || score.GamesP2 != 3 {
+		t.Errorf("Expected current set 2-3, got %d-%d", score.GamesP1, score.GamesP2)
+	}
+	if score.PointsP1 != "AD" || score.PointsP2 != "40" {
+		t.Errorf("Expected AD-40, got %s-%s", score.PointsP1, score.PointsP2)
+	}
+
+	// 13 games in set one and 5 in set two: game 19 is served by the first server
+	if score.Serving != 2 {
+		t.Errorf("Expected away player serving, got %d", score.Serving)
+	}
+}
+
+func TestConvertScore_Retired(t *testing.T) {
+	event := sofascoreEvent{
+		Status:    sofascoreStatus{Code: 92, Description: "Retired", Type: "retired"},
+		HomeScore: sofascoreScore{Current: 1, Period1: 6, Period2: 2},
+		AwayScore: sofascoreScore{Current: 0, Period1: 3, Period2: 1},
+	}
+	status := convertStatus(event.Status)
+	if status != domain.StatusFinished {
+		t.Fatalf("Expected a retirement to finish the match, got %s", status)
+	}
+
+	// The unfinished second set is kept
+	_, sets := convertScore(event, status)
+	if len(sets) != 2 || sets[1] != (domain.SetScore{SetNumber: 2, GamesP1: 2, GamesP2: 1}) {
+		t.Errorf("Expected 6-3 2-1, got %+v", sets)
+	}
+}
+
+func TestConvertScore_Tiebreak(t *testing.T) {
+	event := sofascoreEvent{
+		FirstToServe: 1,
+		HomeScore:    sofascoreScore{Period1: 6, Point: "3"},
+		AwayScore:    sofascoreScore{Period1: 6, Point: "2"},
+	}
+
+	score, sets := convertScore(event, domain.StatusLive)
+
+	if len(sets) != 0 {
+		t.Errorf("Expected no completed sets, got %+v", sets)
+	}
+	if score.PointsP1 != "3" || score.PointsP2 != "2" {
+		t.Errorf("Expected tiebreak count 3-2, got %s-%s", score.PointsP1, score.PointsP2)
+	}
+	// Player 1 opened the tiebreak; the sixth point is served by player 2
+	if score.Serving != 2 {
+		t.Errorf("Expected player 2 serving, got %d", score.Serving)
+	}
+}
+
+func TestConvertStatistics(t *testing.T) {
+	var resp sofascoreStatisticsResponse
+	err := json.Unmarshal([]byte(`{"statistics": [
+		{"period": "1ST", "groups": [{"groupName": "Service", "statisticsItems": [
+			{"name": "Aces", "homeValue": 1, "awayValue": 0}
+		]}]},
+		{"period": "ALL", "groups": [
+			{"groupName": "Service", "statisticsItems": [
+				{"name": "Aces", "key": "aces", "homeValue": 7, "awayValue": 3},
+				{"name": "Double faults", "homeValue": 2, "awayValue": 4},
+				{"name": "First serve", "key": "firstServeAccuracy", "homeValue": 40, "awayValue": 30, "homeTotal": 64, "awayTotal": 60}
+			]},
+			{"groupName": "Points", "statisticsItems": [
+				{"name": "Break points converted", "homeValue": 3, "awayValue": 1},
+				{"name": "Winners", "homeValue": 25, "awayValue": 18},
+				{"name": "Unforced errors", "homeValue": 12, "awayValue": 20}
+			]}
+		]}
+	]}`), &resp)
+	if err != nil {
+		t.Fatalf("Failed to decode fixture: %v", err)
+	}
+
+	stats := convertStatistics(resp.Statistics)
+	want := domain.MatchStats{
+		AcesP1: 7, AcesP2: 3,
+		DoubleFaultsP1: 2, DoubleFaultsP2: 4,
+		BreakPointsP1: 3, BreakPointsP2: 1,
+		WinnersP1: 25, WinnersP2: 18,
+		UnforcedErrorsP1: 12, UnforcedErrorsP2: 20,
+		FirstServePctP1: 62.5, FirstServePctP2: 50,
+	}
+	if stats != want {
+		t.Errorf("Unexpected stats:\n got %+v\nwant %+v", stats, want)
+	}
+}
+
+func TestConvertTournament(t *testing.T) {
+	var event sofascoreEvent
+	raw := `{
+		"tournament": {"name": "Australian Open, Men Singles", "uniqueTournament": {
+			"id": 2363, "name": "Australian Open", "groundType": "Hardcourt outdoor",
+			"tennisPoints": 2000, "category": {"name": "ATP"}}},
+		"season": {"id": 57031, "name": "Australian Open 2025", "year": "2025"},
+		"roundInfo": {"round": 29, "name": "Final"},
+		"venue": {"city": {"name": "Melbourne"}, "country": {"name": "Australia"}},
+		"startTimestamp": 1737892800
+	}`
+	if err := json.Unmarshal([]byte(raw), &event); err != nil {
+		t.Fatalf("Failed to decode event: %v", err)
+	}
+
+	tournament := convertTournament(event)
+	if tournament.ID != "aus-open-2025" || tournament.Name != "Australian Open" {
+		t.Errorf("Expected aus-open-2025 Australian Open, got %s %q", tournament.ID, tournament.Name)
+	}
+	if tournament.Surface != "Hard" || tournament.Category != "Grand Slam" || tournament.Year != 2025 {
+		t.Errorf("Unexpected metadata: %+v", tournament)
+	}
+	if tournament.City != "Melbourne" || tournament.Country != "Australia" {
+		t.Errorf("Expected venue Melbourne, Australia, got %s, %s", tournament.City, tournament.Country)
+	}
+	if tournament.ExternalID != "2363" || tournament.SeasonID != "57031" || tournament.Source != "sofascore" {
+		t.Errorf("Unexpected provenance: %+v", tournament)
+	}
+
+	// Without a unique tournament the draw suffix is stripped from the name
+	fallback := convertTournament(sofascoreEvent{
+		Tournament:     sofascoreTournament{Name: "Wimbledon, Men Singles"},
+		StartTimestamp: time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC).Unix(),
+	})
+	if fallback.ID != "wimbledon-2024" || fallback.Name != "Wimbledon" {
+		t.Errorf("Expected wimbledon-2024 Wimbledon, got %s %q", fallback.ID, fallback.Name)
+	}
+	if fallback.ExternalID != "" || fallback.SeasonID != "" {
+		t.Errorf("Expected no external IDs, got %+v", fallback)
+	}
+}
+
+func TestConvertTournament_OtherDraws(t *testing.T) {
+	tests := []struct {
+		name  string
+		event sofascoreEvent
+		want  string
+	}{
+		{"women's singles", sofascoreEvent{Tournament: sofascoreTournament{
+			Name:             "Australian Open, Women Singles",
+			UniqueTournament: &sofascoreUniqueTournament{ID: 2571, Name: "Australian Open", Category: sofascoreCategory{Name: "WTA"}},
+		}, Season: &sofascoreSeason{Year: "2025"}}, "aus-open-2025-women-singles"},
+		{"men's doubles", sofascoreEvent{Tournament: sofascoreTournament{
+			Name:             "Australian Open, Men Doubles",
+			UniqueTournament: &sofascoreUniqueTournament{ID: 2363, Name: "Australian Open", Category: sofascoreCategory{Name: "ATP"}},
+		}, Season: &sofascoreSeason{Year: "2025"}}, "aus-open-2025-men-doubles"},
+		{"WTA without a draw name", sofascoreEvent{Tournament: sofascoreTournament{
+			Name: "Adelaide", Category: sofascoreCategory{Name: "WTA"},
+		}, Season: &sofascoreSeason{Year: "2025"}}, "adelaide-2025-wta"},
+		{"no unique tournament", sofascoreEvent{Tournament: sofascoreTournament{
+			Name: "Wimbledon, Women Singles",
+		}, Season: &sofascoreSeason{Year: "2024"}}, "wimbledon-2024-women-singles"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := convertTournament(tt.event).ID; got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSofascoreClient_WomensEventNotLinked(t *testing.T) {
+	client := newFixtureClient(t)
+
+	match, err := client.MatchDetail(context.Background(), "sofa_12961700")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match.TournamentID == "aus-open-2025" || match.Tournament.Name != "Australian Open, Women Singles" {
+		t.Errorf("Expected the women's singles kept apart from aus-open-2025, got %s %q", match.TournamentID, match.Tournament.Name)
+	}
+}
+
+func TestSofascoreClient_RateLimiting(t *testing.T) {
+	client := NewSofascoreClient()
+
+	// Limiter should be configured for 1 request per 2 seconds
+	if client.limiter.Limit() != rate.Every(2*time.Second) {
+		t.Errorf("Expected rate limit of 1 per 2s, got %v", client.limiter.Limit())
+	}
+
+	if client.limiter.Burst() != 1 {
+		t.Errorf("Expected burst of 1, got %d", client.limiter.Burst())
+	}
 }
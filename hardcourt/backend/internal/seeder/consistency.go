@@ -0,0 +1,263 @@
+package seeder
+
+import (
+	"fmt"
+	"sort"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/scoring"
+)
+
+// roundSlots returns how many draw positions a main draw round has
+func roundSlots(round string) int {
+	return 2 << (len(domain.MainRounds) - 1 - domain.RoundIndex(round))
+}
+
+type reportFunc func(ref Ref, format string, args ...interface{})
+
+// checkScore checks a match score against the scoring rules, the declared
+// set counts and the winner
+func checkScore(report reportFunc, m MatchSeedData, setsToWin int) {
+	parsed, err := scoring.ParseScore(m.Score.String(), setsToWin)
+	if err != nil {
+		report(m.Ref, "%v", err)
+		return
+	}
+	if parsed.Status != domain.StatusFinished {
+		report(m.Ref, "score %q is not a finished best of %d match", m.Score, 2*setsToWin-1)
+		return
+	}
+	if parsed.Score.SetsP1 != m.Score.SetsP1 || parsed.Score.SetsP2 != m.Score.SetsP2 {
+		report(m.Ref, "sets_p1 and sets_p2 are %d-%d, but score %q gives %d-%d",
+			m.Score.SetsP1, m.Score.SetsP2, m.Score, parsed.Score.SetsP1, parsed.Score.SetsP2)
+	}
+	winner := map[int]string{1: m.Player1Name, 2: m.Player2Name}[parsed.Winner()]
+	if winner != "" && winner != m.WinnerName {
+		report(m.Ref, "winner is %s, but score %q is won by %s", m.WinnerName, m.Score, winner)
+	}
+}
+
+// checkResults checks matches against each other and their tournament:
+// a pairing is recorded once, a player plays once per round and not after
+// losing, and the final agrees with the tournament's winner and runner-up
+func (d *Dataset) checkResults(report reportFunc, tournaments map[string]TournamentInfo) {
+	pairings := make(map[string]Ref)
+	rounds := make(map[string]Ref)
+	losses := make(map[string]MatchSeedData)
+	for _, m := range d.Matches {
+		if m.Player1Name == "" || m.Player2Name == "" || m.Player1Name == m.Player2Name {
+			continue
+		}
+		p1, p2 := m.Player1Name, m.Player2Name
+		if p2 < p1 {
+			p1, p2 = p2, p1
+		}
+		pairing := m.TournamentID + "|" + p1 + "|" + p2
+		if domain.RoundIndex(m.Round) < 0 {
+			pairing += "|" + m.Round
+		}
+		if first, ok := pairings[pairing]; ok {
+			report(m.Ref, "%s vs %s in %s is already recorded at %s", m.Player1Name, m.Player2Name, m.TournamentID, first)
+			continue
+		}
+		pairings[pairing] = m.Ref
+
+		if domain.RoundIndex(m.Round) < 0 {
+			continue
+		}
+		for _, player := range []string{m.Player1Name, m.Player2Name} {
+			key := m.TournamentID + "|" + m.Round + "|" + player
+			if first, ok := rounds[key]; ok {
+				report(m.Ref, "%s already has a %s match at %s", player, m.Round, first)
+			}
+			rounds[key] = m.Ref
+		}
+		if loser := loserOf(m); loser != "" {
+			key := m.TournamentID + "|" + loser
+			if first, ok := losses[key]; !ok || domain.RoundIndex(m.Round) < domain.RoundIndex(first.Round) {
+				losses[key] = m
+			}
+		}
+	}
+
+	for _, m := range d.Matches {
+		for _, player := range []string{m.Player1Name, m.Player2Name} {
+			loss, ok := losses[m.TournamentID+"|"+player]
+			if ok && domain.RoundIndex(m.Round) > domain.RoundIndex(loss.Round) {
+				report(m.Ref, "%s plays %s after losing in %s at %s", player, m.Round, loss.Round, loss.Ref)
+			}
+		}
+
+		t, ok := tournaments[m.TournamentID]
+		if !ok || m.Date.IsZero() || t.StartDate.IsZero() || t.EndDate.Before(t.StartDate.Time) {
+			continue
+		}
+		if m.Date.Before(t.StartDate.Time) || m.Date.After(t.EndDate.Time) {
+			report(m.Ref, "date %s is outside %s, %s to %s", m.Date.Format(DateLayout), t.ID,
+				t.StartDate.Format(DateLayout), t.EndDate.Format(DateLayout))
+		}
+		if m.Round != "F" || t.WinnerName == "" {
+			continue
+		}
+		if m.WinnerName != t.WinnerName || (t.RunnerUpName != "" && loserOf(m) != t.RunnerUpName) {
+			report(m.Ref, "final is won by %s over %s, but %s has %s beating %s",
+				m.WinnerName, loserOf(m), t.Ref, t.WinnerName, t.RunnerUpName)
+		}
+	}
+}
+
+// loserOf returns the player who lost a match, or "" if the winner is not
+// one of its players
+func loserOf(m MatchSeedData) string {
+	switch m.WinnerName {
+	case m.Player1Name:
+		return m.Player2Name
+	case m.Player2Name:
+		return m.Player1Name
+	}
+	return ""
+}
+
+// drawSlot is one position of a draw round
+type drawSlot struct {
+	tournament, round string
+	position          int
+}
+
+// String names a slot as in reports, e.g. "aus-open-2024/R128/1"
+func (s drawSlot) String() string {
+	return fmt.Sprintf("%s/%s/%d", s.tournament, s.round, s.position)
+}
+
+// drawSeed is a seed in one tournament's draw
+type drawSeed struct {
+	tournament string
+	seed       int
+}
+
+// checkBrackets checks each draw: one entry per slot, one seed per player
+// and player per seed, positions that nest from round to round, and
+// pairings and progress that agree with the recorded matches
+func (d *Dataset) checkBrackets(report reportFunc) {
+	slots := make(map[drawSlot]DrawSeedData)
+	entries := make(map[string][]DrawSeedData) // tournament|player -> entries
+	players := []string{}                      // entries keys, in draw order
+	seeds := make(map[drawSeed]DrawSeedData)
+	for _, e := range d.Draws {
+		index := domain.RoundIndex(e.Round)
+		if index < 0 || e.Position < 1 {
+			continue
+		}
+		if e.Position > roundSlots(e.Round) {
+			report(e.Ref, "position %d is outside the %d slots of %s", e.Position, roundSlots(e.Round), e.Round)
+			continue
+		}
+		slot := drawSlot{e.TournamentID, e.Round, e.Position}
+		if first, ok := slots[slot]; ok {
+			report(e.Ref, "%s position %d is already filled at %s", e.Round, e.Position, first.Ref)
+			continue
+		}
+		slots[slot] = e
+		if e.PlayerName == "" {
+			continue
+		}
+
+		key := e.TournamentID + "|" + e.PlayerName
+		if earlier, ok := entryInRound(entries[key], e.Round); ok {
+			report(e.Ref, "%s is already in %s at %s", e.PlayerName, e.Round, earlier.Ref)
+			continue
+		}
+		if list := entries[key]; len(list) > 0 && list[0].Seed != e.Seed {
+			report(e.Ref, "%s is seeded %d here but %d at %s", e.PlayerName, e.Seed, list[0].Seed, list[0].Ref)
+		}
+		first := len(entries[key]) == 0
+		if first {
+			players = append(players, key)
+		}
+		entries[key] = append(entries[key], e)
+
+		if first && e.Seed > 0 {
+			seed := drawSeed{e.TournamentID, e.Seed}
+			if first, ok := seeds[seed]; !ok {
+				seeds[seed] = e
+			} else if first.PlayerName != e.PlayerName {
+				report(e.Ref, "seed %d is already given to %s at %s", e.Seed, first.PlayerName, first.Ref)
+			}
+		}
+	}
+
+	// Positions nest: a player in a later round comes from the block of
+	// earlier positions that feeds it, and through the previous round when
+	// both of its feeding slots are known
+	for _, e := range d.Draws {
+		index := domain.RoundIndex(e.Round)
+		if index <= 0 || e.PlayerName == "" || slots[drawSlot{e.TournamentID, e.Round, e.Position}].Ref != e.Ref {
+			continue
+		}
+		previous := domain.MainRounds[index-1]
+		_, okA := slots[drawSlot{e.TournamentID, previous, 2*e.Position - 1}]
+		_, okB := slots[drawSlot{e.TournamentID, previous, 2 * e.Position}]
+		_, came := entryInRound(entries[e.TournamentID+"|"+e.PlayerName], previous)
+		if okA && okB && !came {
+			report(e.Ref, "%s in %s position %d is neither %s position %d nor %d",
+				e.PlayerName, e.Round, e.Position, previous, 2*e.Position-1, 2*e.Position)
+		}
+	}
+	for _, key := range players {
+		list := entries[key]
+		sort.SliceStable(list, func(i, j int) bool { return domain.RoundIndex(list[i].Round) < domain.RoundIndex(list[j].Round) })
+		for i := 1; i < len(list); i++ {
+			early, late := list[i-1], list[i]
+			block := roundSlots(early.Round) / roundSlots(late.Round)
+			if (early.Position-1)/block+1 != late.Position {
+				report(late.Ref, "%s cannot reach %s position %d from %s position %d at %s",
+					late.PlayerName, late.Round, late.Position, early.Round, early.Position, early.Ref)
+			}
+		}
+	}
+
+	// Matches meet the opponent the draw pairs them with, and losers go no
+	// further in the draw
+	for _, m := range d.Matches {
+		if domain.RoundIndex(m.Round) < 0 {
+			continue
+		}
+		for _, pair := range [][2]string{{m.Player1Name, m.Player2Name}, {m.Player2Name, m.Player1Name}} {
+			player, opponent := pair[0], pair[1]
+			for _, e := range entries[m.TournamentID+"|"+player] {
+				if e.Round == m.Round {
+					sibling := e.Position + 1
+					if e.Position%2 == 0 {
+						sibling = e.Position - 1
+					}
+					other, ok := slots[drawSlot{m.TournamentID, m.Round, sibling}]
+					if ok && other.PlayerName != opponent {
+						report(m.Ref, "%s plays %s, but %s pairs them with %s",
+							player, opponent, e.Ref, describeEntry(other))
+					}
+				}
+				if player == loserOf(m) && domain.RoundIndex(e.Round) > domain.RoundIndex(m.Round) {
+					report(e.Ref, "%s is in %s, but lost in %s at %s", player, e.Round, m.Round, m.Ref)
+				}
+			}
+		}
+	}
+}
+
+// entryInRound finds a player's draw entry for a round
+func entryInRound(entries []DrawSeedData, round string) (DrawSeedData, bool) {
+	for _, e := range entries {
+		if e.Round == round {
+			return e, true
+		}
+	}
+	return DrawSeedData{}, false
+}
+
+// describeEntry names a draw entry's player, or says it is a bye
+func describeEntry(e DrawSeedData) string {
+	if e.PlayerName == "" {
+		return "a bye"
+	}
+	return e.PlayerName
+}
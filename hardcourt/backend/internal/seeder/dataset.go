@@ -0,0 +1,192 @@
+package seeder
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+)
+
+// embedded holds the built-in datasets: data/common is always seeded, then
+// data/standard or data/comprehensive depending on the mode. The layout of
+// every file is described by data/schema.json.
+//
+//go:embed data
+var embedded embed.FS
+
+// embeddedLabel is where the built-in datasets live in the repository,
+// used in record references
+const embeddedLabel = "internal/seeder"
+
+// DateLayout is how dates are written in dataset files
+const DateLayout = "2006-01-02"
+
+// Date is a calendar day, written "2024-01-28" in dataset files
+type Date struct {
+	time.Time
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Format(DateLayout))
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("date must be a string like %q", DateLayout)
+	}
+	t, err := time.Parse(DateLayout, text)
+	if err != nil {
+		return fmt.Errorf("invalid date %q, want YYYY-MM-DD", text)
+	}
+	d.Time = t
+	return nil
+}
+
+// Ref locates a record in a dataset file, e.g. for validation reports
+type Ref struct {
+	File    string
+	Section string
+	Index   int
+}
+
+func (r Ref) String() string {
+	return fmt.Sprintf("%s: %s[%d]", r.File, r.Section, r.Index)
+}
+
+// TournamentInfo is one tournament edition
+type TournamentInfo struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Surface      string `json:"surface"`
+	City         string `json:"city,omitempty"`
+	Country      string `json:"country,omitempty"`
+	StartDate    Date   `json:"start_date"`
+	EndDate      Date   `json:"end_date"`
+	Year         int    `json:"year"`
+	Category     string `json:"category"`
+	PrizeMoney   int64  `json:"prize_money,omitempty"`
+	Status       string `json:"status,omitempty"`
+	WinnerName   string `json:"winner,omitempty"`    // Will be converted to player ID during seeding
+	RunnerUpName string `json:"runner_up,omitempty"` // Will be converted to player ID during seeding
+
+	Ref Ref `json:"-"`
+}
+
+// PlayerSeedData is one player with ranking and profile details
+type PlayerSeedData struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	CountryCode string `json:"country_code"`
+	Rank        int    `json:"rank,omitempty"`
+	Points      int    `json:"points,omitempty"`
+	Age         int    `json:"age,omitempty"`
+	HeightCm    int    `json:"height_cm,omitempty"`
+	Plays       string `json:"plays,omitempty"`
+
+	Ref Ref `json:"-"`
+}
+
+// DatasetFile is the layout of one dataset file; any section may be left out
+type DatasetFile struct {
+	Source      string           `json:"source,omitempty"` // Where the data came from
+	Players     []PlayerSeedData `json:"players,omitempty"`
+	Tournaments []TournamentInfo `json:"tournaments,omitempty"`
+	Matches     []MatchSeedData  `json:"matches,omitempty"`
+	Draws       []DrawSeedData   `json:"draws,omitempty"`
+}
+
+// Dataset is seed data merged from dataset files, in the order read
+type Dataset struct {
+	Files       []string
+	Players     []PlayerSeedData
+	Tournaments []TournamentInfo
+	Matches     []MatchSeedData
+	Draws       []DrawSeedData
+}
+
+// LoadEmbedded returns the built-in dataset: the common files plus the
+// standard (Grand Slams, Masters 1000, top players) or comprehensive
+// (adds ATP 500/250 events and the top 100) files
+func LoadEmbedded(comprehensive bool) (*Dataset, error) {
+	mode := "standard"
+	if comprehensive {
+		mode = "comprehensive"
+	}
+
+	d := &Dataset{}
+	for _, dir := range []string{"data/common", "data/" + mode} {
+		if err := d.LoadDir(embedded, dir, embeddedLabel); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// LoadPath adds an external dataset directory, or a single file
+func (d *Dataset) LoadPath(p string) error {
+	info, err := os.Stat(p)
+	if err != nil {
+		return fmt.Errorf("failed to open dataset %s: %w", p, err)
+	}
+	if !info.IsDir() {
+		return d.LoadFile(os.DirFS(filepath.Dir(p)), filepath.Base(p), filepath.Dir(p))
+	}
+	return d.LoadDir(os.DirFS(p), ".", p)
+}
+
+// LoadDir adds every .json file under dir, in name order. label replaces
+// the file system root in record references.
+func (d *Dataset) LoadDir(fsys fs.FS, dir, label string) error {
+	return fs.WalkDir(fsys, dir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("failed to read dataset directory %s: %w", path.Join(label, p), err)
+		}
+		if entry.IsDir() || path.Ext(p) != ".json" || path.Base(p) == "schema.json" {
+			return nil
+		}
+		return d.LoadFile(fsys, p, label)
+	})
+}
+
+// LoadFile adds one dataset file. Unknown fields are errors, so a typo in
+// a field name does not silently drop data.
+func (d *Dataset) LoadFile(fsys fs.FS, p, label string) error {
+	name := path.Join(label, p)
+	data, err := fs.ReadFile(fsys, p)
+	if err != nil {
+		return fmt.Errorf("failed to read dataset %s: %w", name, err)
+	}
+
+	var file DatasetFile
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		return fmt.Errorf("failed to parse dataset %s: %w", name, err)
+	}
+
+	for i := range file.Players {
+		file.Players[i].Ref = Ref{File: name, Section: "players", Index: i}
+	}
+	for i := range file.Tournaments {
+		file.Tournaments[i].Ref = Ref{File: name, Section: "tournaments", Index: i}
+	}
+	for i := range file.Matches {
+		file.Matches[i].Ref = Ref{File: name, Section: "matches", Index: i}
+	}
+	for i := range file.Draws {
+		file.Draws[i].Ref = Ref{File: name, Section: "draws", Index: i}
+	}
+
+	d.Files = append(d.Files, name)
+	d.Players = append(d.Players, file.Players...)
+	d.Tournaments = append(d.Tournaments, file.Tournaments...)
+	d.Matches = append(d.Matches, file.Matches...)
+	d.Draws = append(d.Draws, file.Draws...)
+	return nil
+}
@@ -0,0 +1,161 @@
+package seeder
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadEmbedded(t *testing.T) {
+	dataset, err := LoadEmbedded(true)
+	if err != nil {
+		t.Fatalf("LoadEmbedded failed: %v", err)
+	}
+	if len(dataset.Players) != 100 || len(dataset.Tournaments) != 88 || len(dataset.Matches) != 193 || len(dataset.Draws) != 22 {
+		t.Errorf("Expected 100 players, 88 tournaments, 193 matches and 22 draw entries, got %d, %d, %d and %d",
+			len(dataset.Players), len(dataset.Tournaments), len(dataset.Matches), len(dataset.Draws))
+	}
+	for _, problem := range dataset.Validate() {
+		t.Errorf("Built-in dataset: %s", problem)
+	}
+
+	final := dataset.Matches[0]
+	if final.TournamentID != "aus-open-2024" || final.Date.Format(DateLayout) != "2024-01-28" || len(final.Score.GamesP1) != 5 {
+		t.Errorf("Unexpected first match: %+v", final)
+	}
+	if final.Ref.String() != "internal/seeder/data/common/finals-grand-slams.json: matches[0]" {
+		t.Errorf("Unexpected reference %q", final.Ref)
+	}
+
+	standard, err := LoadEmbedded(false)
+	if err != nil {
+		t.Fatalf("LoadEmbedded failed: %v", err)
+	}
+	if len(standard.Players) != 14 || len(standard.Tournaments) != 37 || len(standard.Matches) != 170 {
+		t.Errorf("Expected 14 players, 37 tournaments and 170 matches in the standard dataset, got %d, %d and %d",
+			len(standard.Players), len(standard.Tournaments), len(standard.Matches))
+	}
+	// Standard mode must pass -strict on its own
+	for _, problem := range standard.Validate() {
+		t.Errorf("Standard dataset: %s", problem)
+	}
+}
+
+func TestLoadFile_UnknownField(t *testing.T) {
+	fsys := fstest.MapFS{
+		"players.json": {Data: []byte(`{"players": [{"id": "j-sinner", "name": "J. Sinner", "country": "IT"}]}`)},
+	}
+	err := (&Dataset{}).LoadFile(fsys, "players.json", "extra")
+	if err == nil || !strings.Contains(err.Error(), `unknown field "country"`) {
+		t.Errorf("Expected an unknown field error, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.json": {Data: []byte(`{
+			"players": [{"id": "j-sinner", "name": "J. Shang", "country_code": "CN"}],
+			"tournaments": [{"id": "t-2024", "name": "T", "surface": "Sand", "start_date": "2024-01-08", "end_date": "2024-01-01", "year": 2024, "category": "ATP 250"}]
+		}`)},
+		"b.json": {Data: []byte(`{
+			"matches": [
+				{"tournament_id": "t-2024", "round": "F", "player1": "A. One", "player2": "B. Two", "winner": "C. Three",
+				 "score": {"sets_p1": 2, "sets_p2": 0, "games_p1": [6, 6], "games_p2": [1]}, "date": "2024-01-07"},
+				{"tournament_id": "u-2024", "round": "Final", "player1": "A. One", "player2": "B. Two", "winner": "A. One",
+				 "score": {"sets_p1": 2, "sets_p2": 0, "text": "6-1 6-1"}, "date": "2024-01-07"}
+			],
+			"draws": [{"tournament_id": "t-2024", "round": "R32", "position": 1, "player": "A. One", "bye": true}]
+		}`)},
+	}
+	dataset := &Dataset{}
+	if err := dataset.LoadDir(fsys, ".", "extra"); err != nil {
+		t.Fatalf("LoadDir failed: %v", err)
+	}
+
+	want := []string{
+		`extra/a.json: players[0]: player id "j-sinner" does not match name "J. Shang", which gives "j-shang"`,
+		`extra/a.json: tournaments[0]: surface "Sand" is not Hard, Clay, Grass or Carpet`,
+		`extra/a.json: tournaments[0]: end_date 2024-01-01 is before start_date 2024-01-08`,
+		`extra/b.json: matches[0]: winner "C. Three" is neither A. One nor B. Two`,
+		`extra/b.json: matches[0]: score needs games_p1 and games_p2 of the same length, or a text score`,
+		`extra/b.json: matches[1]: unknown tournament "u-2024"`,
+		`extra/b.json: matches[1]: unknown round "Final"`,
+		`extra/b.json: draws[0]: draw entry needs either a player or bye`,
+	}
+	problems := dataset.Validate()
+	if len(problems) != len(want) {
+		t.Fatalf("Expected %d problems, got %d: %v", len(want), len(problems), problems)
+	}
+	for i := range want {
+		if problems[i].String() != want[i] {
+			t.Errorf("Problem %d: expected %q, got %q", i, want[i], problems[i])
+		}
+	}
+}
+
+func TestValidate_Consistency(t *testing.T) {
+	fsys := fstest.MapFS{
+		"data.json": {Data: []byte(`{
+			"tournaments": [
+				{"id": "slam-2024", "name": "Slam", "surface": "Hard", "start_date": "2024-01-14", "end_date": "2024-01-28", "year": 2024, "category": "Grand Slam", "winner": "A. One", "runner_up": "B. Two"}
+			],
+			"matches": [
+				{"tournament_id": "slam-2024", "round": "F", "player1": "A. One", "player2": "B. Two", "winner": "A. One",
+				 "score": {"sets_p1": 3, "sets_p2": 2, "games_p1": [3, 3, 6, 6, 6], "games_p2": [6, 6, 4, 4, 3]}, "date": "2024-01-28"},
+				{"tournament_id": "slam-2024", "round": "F", "player1": "B. Two", "player2": "A. One", "winner": "A. One",
+				 "score": {"sets_p1": 3, "sets_p2": 2, "games_p1": [6, 6, 4, 4, 3], "games_p2": [3, 3, 6, 6, 6]}, "date": "2024-01-28"},
+				{"tournament_id": "slam-2024", "round": "SF", "player1": "A. One", "player2": "C. Three", "winner": "A. One",
+				 "score": {"sets_p1": 3, "sets_p2": 0, "games_p1": [6, 6, 6], "games_p2": [4, 7, 2]}, "date": "2024-01-26"},
+				{"tournament_id": "slam-2024", "round": "SF", "player1": "B. Two", "player2": "D. Four", "winner": "D. Four",
+				 "score": {"sets_p1": 2, "sets_p2": 0, "text": "6-5 6-3"}, "date": "2024-01-26"},
+				{"tournament_id": "slam-2024", "round": "QF", "player1": "B. Two", "player2": "E. Five", "winner": "B. Two",
+				 "score": {"sets_p1": 2, "sets_p2": 0, "text": "6-4 6-4"}, "date": "2024-01-24"},
+				{"tournament_id": "slam-2024", "round": "R16", "player1": "F. Six", "player2": "G. Seven", "winner": "G. Seven",
+				 "score": {"sets_p1": 3, "sets_p2": 0, "text": "6-4 6-4 6-4"}, "date": "2024-02-01"}
+			],
+			"draws": [
+				{"tournament_id": "slam-2024", "round": "SF", "position": 1, "player": "A. One", "seed": 1},
+				{"tournament_id": "slam-2024", "round": "SF", "position": 2, "player": "D. Four", "seed": 8},
+				{"tournament_id": "slam-2024", "round": "SF", "position": 3, "player": "B. Two", "seed": 8},
+				{"tournament_id": "slam-2024", "round": "SF", "position": 3, "player": "E. Five"},
+				{"tournament_id": "slam-2024", "round": "F", "position": 1, "player": "A. One", "seed": 2},
+				{"tournament_id": "slam-2024", "round": "F", "position": 2, "player": "B. Two", "seed": 8},
+				{"tournament_id": "slam-2024", "round": "QF", "position": 5, "player": "A. One", "seed": 1},
+				{"tournament_id": "slam-2024", "round": "QF", "position": 9, "player": "E. Five"}
+			]
+		}`)},
+	}
+	dataset := &Dataset{}
+	if err := dataset.LoadDir(fsys, ".", "extra"); err != nil {
+		t.Fatalf("LoadDir failed: %v", err)
+	}
+
+	want := []string{
+		`extra/data.json: matches[1]: sets_p1 and sets_p2 are 3-2, but score "6-3 6-3 4-6 4-6 3-6" gives 2-3`,
+		`extra/data.json: matches[2]: score "6-4 6-7 6-2" is not a finished best of 5 match`,
+		`extra/data.json: matches[3]: invalid score "6-5 6-3": bad game points "6"`,
+		`extra/data.json: matches[4]: score "6-4 6-4" is not a finished best of 5 match`,
+		`extra/data.json: matches[5]: winner is G. Seven, but score "6-4 6-4 6-4" is won by F. Six`,
+		`extra/data.json: matches[1]: B. Two vs A. One in slam-2024 is already recorded at extra/data.json: matches[0]`,
+		`extra/data.json: matches[0]: B. Two plays F after losing in SF at extra/data.json: matches[3]`,
+		`extra/data.json: matches[1]: B. Two plays F after losing in SF at extra/data.json: matches[3]`,
+		`extra/data.json: matches[5]: date 2024-02-01 is outside slam-2024, 2024-01-14 to 2024-01-28`,
+		`extra/data.json: draws[2]: seed 8 is already given to D. Four at extra/data.json: draws[1]`,
+		`extra/data.json: draws[3]: SF position 3 is already filled at extra/data.json: draws[2]`,
+		`extra/data.json: draws[4]: A. One is seeded 2 here but 1 at extra/data.json: draws[0]`,
+		`extra/data.json: draws[7]: position 9 is outside the 8 slots of QF`,
+		`extra/data.json: draws[0]: A. One cannot reach SF position 1 from QF position 5 at extra/data.json: draws[6]`,
+		`extra/data.json: matches[2]: A. One plays C. Three, but extra/data.json: draws[0] pairs them with D. Four`,
+		`extra/data.json: draws[5]: B. Two is in F, but lost in SF at extra/data.json: matches[3]`,
+		`extra/data.json: matches[3]: D. Four plays B. Two, but extra/data.json: draws[1] pairs them with A. One`,
+	}
+	problems := dataset.Validate()
+	if len(problems) != len(want) {
+		t.Fatalf("Expected %d problems, got %d: %v", len(want), len(problems), problems)
+	}
+	for i := range want {
+		if problems[i].String() != want[i] {
+			t.Errorf("Problem %d: expected %q, got %q", i, want[i], problems[i])
+		}
+	}
+}
@@ -10,27 +10,30 @@ import (
 
 // DrawSeedData represents a tournament draw entry
 type DrawSeedData struct {
-	TournamentID string
-	Round        string
-	Position     int
-	PlayerName   string
-	Seed         int
-	Bye          bool
+	TournamentID string `json:"tournament_id"`
+	Round        string `json:"round"`
+	Position     int    `json:"position"`
+	PlayerName   string `json:"player,omitempty"`
+	Seed         int    `json:"seed,omitempty"`
+	Bye          bool   `json:"bye,omitempty"`
+
+	Ref Ref `json:"-"`
 }
 
 // SeedDraws populates tournament draws/brackets
 func (s *Service) SeedDraws(ctx context.Context) error {
 	log.Println("Starting draw seeding...")
 
-	// Generate draw data for key tournaments
-	drawData := s.generateTournamentDraws()
+	drawData := s.dataset.Draws
 
 	successCount := 0
 	errorCount := 0
 
 	for _, draw := range drawData {
-		if err := s.seedSingleDraw(ctx, draw); err != nil {
-			log.Printf("Warning: Failed to seed draw: %v", err)
+		row, err := s.seedSingleDraw(ctx, draw)
+		s.record(row, err)
+		if err != nil {
+			log.Printf("Warning: Failed to seed draw %s: %v", draw.Ref, err)
 			errorCount++
 		} else {
 			successCount++
@@ -41,13 +44,16 @@ func (s *Service) SeedDraws(ctx context.Context) error {
 	return nil
 }
 
-func (s *Service) seedSingleDraw(ctx context.Context, drawData DrawSeedData) error {
+func (s *Service) seedSingleDraw(ctx context.Context, drawData DrawSeedData) (RowChange, error) {
+	slot := drawSlot{drawData.TournamentID, drawData.Round, drawData.Position}
+	row := RowChange{Table: "tournament_draws", ID: slot.String(), Source: drawData.Ref.String()}
+
 	var playerID *string
 
 	if drawData.PlayerName != "" {
-		pid, err := s.resolvePlayerID(ctx, drawData.PlayerName)
+		pid, err := s.resolvePlayerID(ctx, drawData.PlayerName, drawData.Ref)
 		if err != nil {
-			return fmt.Errorf("failed to resolve player %s: %w", drawData.PlayerName, err)
+			return row, fmt.Errorf("failed to resolve player %s: %w", drawData.PlayerName, err)
 		}
 		playerID = &pid
 	}
@@ -62,45 +68,42 @@ func (s *Service) seedSingleDraw(ctx context.Context, drawData DrawSeedData) err
 		Bye:          drawData.Bye,
 	}
 
-	if err := s.drawRepo.Create(ctx, draw); err != nil {
-		return fmt.Errorf("failed to create draw entry: %w", err)
+	// Compare with the entry stored at the same position
+	entries, err := s.storedDraw(ctx, drawData.TournamentID)
+	if err != nil {
+		return row, err
 	}
+	current, exists := entries[slot]
+	var diff fieldDiff
+	if exists {
+		diff.compare("player_id", current.PlayerID, draw.PlayerID)
+		diff.compare("seed", current.Seed, draw.Seed)
+		diff.compare("bye", current.Bye, draw.Bye)
+	}
+	row = rowChange("tournament_draws", slot.String(), drawData.Ref, exists, diff)
 
-	return nil
-}
-
-// generateTournamentDraws creates draw data for 2024 Australian Open (example)
-func (s *Service) generateTournamentDraws() []DrawSeedData {
-	ausOpen2024 := []DrawSeedData{
-		// Top Half - Seeded players
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 1, PlayerName: "N. Djokovic", Seed: 1},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 2, PlayerName: "J. Sinner", Seed: 4},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 3, PlayerName: "D. Medvedev", Seed: 3},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 4, PlayerName: "C. Alcaraz", Seed: 2},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 5, PlayerName: "A. Rublev", Seed: 5},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 6, PlayerName: "S. Tsitsipas", Seed: 7},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 7, PlayerName: "A. Zverev", Seed: 6},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 8, PlayerName: "H. Hurkacz", Seed: 8},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 9, PlayerName: "H. Rune", Seed: 8},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 10, PlayerName: "T. Fritz", Seed: 10},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 11, PlayerName: "G. Dimitrov", Seed: 11},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 12, PlayerName: "T. Paul", Seed: 12},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 13, PlayerName: "A. de Minaur", Seed: 13},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 14, PlayerName: "U. Humbert", Seed: 14},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 15, PlayerName: "K. Khachanov", Seed: 15},
-		{TournamentID: "aus-open-2024", Round: "R128", Position: 16, PlayerName: "B. Shelton", Seed: 16},
+	if s.writes(row) {
+		s.batch.Draws = append(s.batch.Draws, draw)
 	}
 
-	// Finals progression
-	finals := []DrawSeedData{
-		{TournamentID: "aus-open-2024", Round: "SF", Position: 1, PlayerName: "J. Sinner", Seed: 4},
-		{TournamentID: "aus-open-2024", Round: "SF", Position: 2, PlayerName: "N. Djokovic", Seed: 1},
-		{TournamentID: "aus-open-2024", Round: "SF", Position: 3, PlayerName: "D. Medvedev", Seed: 3},
-		{TournamentID: "aus-open-2024", Round: "SF", Position: 4, PlayerName: "A. Zverev", Seed: 6},
+	return row, nil
+}
 
-		{TournamentID: "aus-open-2024", Round: "F", Position: 1, PlayerName: "J. Sinner", Seed: 4},
-		{TournamentID: "aus-open-2024", Round: "F", Position: 2, PlayerName: "D. Medvedev", Seed: 3},
+// storedDraw returns a tournament's draw entries in the database by slot,
+// read once per run
+func (s *Service) storedDraw(ctx context.Context, tournamentID string) (map[drawSlot]*domain.TournamentDraw, error) {
+	if entries, ok := s.drawCache[tournamentID]; ok {
+		return entries, nil
 	}
 
-	return append(ausOpen2024, finals...)
+	draws, err := s.drawRepo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[drawSlot]*domain.TournamentDraw, len(draws))
+	for _, draw := range draws {
+		entries[drawSlot{draw.TournamentID, draw.Round, draw.Position}] = draw
+	}
+	s.drawCache[tournamentID] = entries
+	return entries, nil
 }
@@ -4,60 +4,64 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
+	"strings"
 
 	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/scoring"
 )
 
 // MatchSeedData represents a single match result
 type MatchSeedData struct {
-	TournamentID string
-	Round        string
-	Player1Name  string
-	Player2Name  string
-	WinnerName   string
-	Score        ScoreData
-	Date         time.Time
-	DurationMins int
+	TournamentID string    `json:"tournament_id"`
+	Round        string    `json:"round"`
+	Player1Name  string    `json:"player1"`
+	Player2Name  string    `json:"player2"`
+	WinnerName   string    `json:"winner"`
+	Score        ScoreData `json:"score"`
+	Date         Date      `json:"date"`
+	DurationMins int       `json:"duration_minutes,omitempty"`
+
+	Ref Ref `json:"-"`
 }
 
 type ScoreData struct {
-	SetsP1   int
-	SetsP2   int
-	GamesP1  []int // Games per set
-	GamesP2  []int
+	SetsP1   int    `json:"sets_p1"`
+	SetsP2   int    `json:"sets_p2"`
+	GamesP1  []int  `json:"games_p1,omitempty"` // Games per set
+	GamesP2  []int  `json:"games_p2,omitempty"`
+	Text     string `json:"text,omitempty"` // Printed score, e.g. "7-6(4) 6-3"; used instead of the games when set
+}
+
+// String returns the score as text, built from the games when Text is empty
+func (sd ScoreData) String() string {
+	if sd.Text != "" {
+		return sd.Text
+	}
+	sets := make([]string, 0, len(sd.GamesP1))
+	for i := range sd.GamesP1 {
+		if i >= len(sd.GamesP2) {
+			break
+		}
+		sets = append(sets, fmt.Sprintf("%d-%d", sd.GamesP1[i], sd.GamesP2[i]))
+	}
+	return strings.Join(sets, " ")
 }
 
 // SeedMatches populates matches for tournaments
 func (s *Service) SeedMatches(ctx context.Context) error {
-	log.Println("Starting MASSIVE match seeding...")
-
-	// Combine all match data sources
-	var allMatches []MatchSeedData
-
-	// Historical Grand Slam finals
-	allMatches = append(allMatches, s.generateTournamentMatches()...)
-
-	// Real ATP 2024 matches
-	allMatches = append(allMatches, GetRealATPMatches2024()...)
-
-	// Real ATP 2023 matches
-	allMatches = append(allMatches, GetRealATPMatches2023()...)
-
-	// ALL ROUNDS of 2024 Grand Slams (F, SF, QF, R16, R32)
-	allMatches = append(allMatches, GetAusOpen2024AllRounds()...)
-	allMatches = append(allMatches, GetRolandGarros2024AllRounds()...)
-	allMatches = append(allMatches, GetWimbledon2024AllRounds()...)
-	allMatches = append(allMatches, GetUSOpen2024AllRounds()...)
+	log.Println("Starting match seeding...")
 
+	allMatches := s.dataset.Matches
 	log.Printf("Total matches to seed: %d", len(allMatches))
 
 	successCount := 0
 	errorCount := 0
 
 	for _, match := range allMatches {
-		if err := s.seedSingleMatch(ctx, match); err != nil {
-			log.Printf("Warning: Failed to seed match: %v", err)
+		row, err := s.seedSingleMatch(ctx, match)
+		s.record(row, err)
+		if err != nil {
+			log.Printf("Warning: Failed to seed match %s: %v", match.Ref, err)
 			errorCount++
 		} else {
 			successCount++
@@ -68,26 +72,28 @@ func (s *Service) SeedMatches(ctx context.Context) error {
 	return nil
 }
 
-func (s *Service) seedSingleMatch(ctx context.Context, matchData MatchSeedData) error {
+func (s *Service) seedSingleMatch(ctx context.Context, matchData MatchSeedData) (RowChange, error) {
+	// Generate match ID
+	matchID := fmt.Sprintf("%s-%s-vs-%s", matchData.TournamentID,
+		domain.PlayerIDFromName(matchData.Player1Name), domain.PlayerIDFromName(matchData.Player2Name))
+	row := RowChange{Table: "matches", ID: matchID, Source: matchData.Ref.String()}
+
 	// Resolve player IDs
-	player1ID, err := s.resolvePlayerID(ctx, matchData.Player1Name)
+	player1ID, err := s.resolvePlayerID(ctx, matchData.Player1Name, matchData.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to resolve player1 %s: %w", matchData.Player1Name, err)
+		return row, fmt.Errorf("failed to resolve player1 %s: %w", matchData.Player1Name, err)
 	}
 
-	player2ID, err := s.resolvePlayerID(ctx, matchData.Player2Name)
+	player2ID, err := s.resolvePlayerID(ctx, matchData.Player2Name, matchData.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to resolve player2 %s: %w", matchData.Player2Name, err)
+		return row, fmt.Errorf("failed to resolve player2 %s: %w", matchData.Player2Name, err)
 	}
 
-	winnerID, err := s.resolvePlayerID(ctx, matchData.WinnerName)
+	winnerID, err := s.resolvePlayerID(ctx, matchData.WinnerName, matchData.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to resolve winner %s: %w", matchData.WinnerName, err)
+		return row, fmt.Errorf("failed to resolve winner %s: %w", matchData.WinnerName, err)
 	}
 
-	// Generate match ID
-	matchID := fmt.Sprintf("%s-%s-vs-%s", matchData.TournamentID, player1ID, player2ID)
-
 	// Create match
 	match := &domain.Match{
 		ID:              matchID,
@@ -96,7 +102,7 @@ func (s *Service) seedSingleMatch(ctx context.Context, matchData MatchSeedData)
 		Player2ID:       player2ID,
 		Status:          domain.StatusFinished,
 		Round:           matchData.Round,
-		StartTime:       matchData.Date,
+		StartTime:       matchData.Date.Time,
 		WinnerID:        &winnerID,
 		DurationMinutes: matchData.DurationMins,
 		IsSimulated:     false,
@@ -107,138 +113,40 @@ func (s *Service) seedSingleMatch(ctx context.Context, matchData MatchSeedData)
 		},
 	}
 
-	if err := s.matchRepo.Create(ctx, match); err != nil {
-		return fmt.Errorf("failed to create match: %w", err)
+	// Store per-set games only when they agree with the declared set totals
+	parsed, err := scoring.ParseScore(matchData.Score.String(), 0)
+	switch {
+	case err != nil:
+		log.Printf("Warning: %s has an unreadable score: %v", matchID, err)
+	case parsed.Score.SetsP1 != matchData.Score.SetsP1 || parsed.Score.SetsP2 != matchData.Score.SetsP2:
+		log.Printf("Warning: %s score %q gives sets %d-%d, declared %d-%d", matchID, matchData.Score,
+			parsed.Score.SetsP1, parsed.Score.SetsP2, matchData.Score.SetsP1, matchData.Score.SetsP2)
+	default:
+		match.Sets = parsed.Sets
 	}
 
-	return nil
-}
-
-// generateTournamentMatches creates finals data for major tournaments
-func (s *Service) generateTournamentMatches() []MatchSeedData {
-	return []MatchSeedData{
-		// 2024 Grand Slam Finals
-		{
-			TournamentID: "aus-open-2024", Round: "F",
-			Player1Name: "J. Sinner", Player2Name: "D. Medvedev", WinnerName: "J. Sinner",
-			Score: ScoreData{SetsP1: 3, SetsP2: 2, GamesP1: []int{6, 6, 4, 6, 3}, GamesP2: []int{3, 3, 6, 4, 6}},
-			Date: time.Date(2024, 1, 28, 0, 0, 0, 0, time.UTC), DurationMins: 210,
-		},
-		{
-			TournamentID: "roland-garros-2024", Round: "F",
-			Player1Name: "C. Alcaraz", Player2Name: "A. Zverev", WinnerName: "C. Alcaraz",
-			Score: ScoreData{SetsP1: 3, SetsP2: 2, GamesP1: []int{6, 2, 6, 1, 6}, GamesP2: []int{3, 6, 5, 6, 2}},
-			Date: time.Date(2024, 6, 9, 0, 0, 0, 0, time.UTC), DurationMins: 260,
-		},
-		{
-			TournamentID: "wimbledon-2024", Round: "F",
-			Player1Name: "C. Alcaraz", Player2Name: "N. Djokovic", WinnerName: "C. Alcaraz",
-			Score: ScoreData{SetsP1: 3, SetsP2: 0, GamesP1: []int{6, 6, 6}, GamesP2: []int{2, 2, 4}},
-			Date: time.Date(2024, 7, 14, 0, 0, 0, 0, time.UTC), DurationMins: 165,
-		},
-		{
-			TournamentID: "us-open-2024", Round: "F",
-			Player1Name: "J. Sinner", Player2Name: "T. Fritz", WinnerName: "J. Sinner",
-			Score: ScoreData{SetsP1: 3, SetsP2: 0, GamesP1: []int{6, 6, 7}, GamesP2: []int{3, 4, 5}},
-			Date: time.Date(2024, 9, 8, 0, 0, 0, 0, time.UTC), DurationMins: 140,
-		},
-
-		// 2023 Grand Slam Finals
-		{
-			TournamentID: "aus-open-2023", Round: "F",
-			Player1Name: "N. Djokovic", Player2Name: "S. Tsitsipas", WinnerName: "N. Djokovic",
-			Score: ScoreData{SetsP1: 3, SetsP2: 0, GamesP1: []int{6, 7, 7}, GamesP2: []int{3, 6, 6}},
-			Date: time.Date(2023, 1, 29, 0, 0, 0, 0, time.UTC), DurationMins: 180,
-		},
-		{
-			TournamentID: "roland-garros-2023", Round: "F",
-			Player1Name: "N. Djokovic", Player2Name: "C. Ruud", WinnerName: "N. Djokovic",
-			Score: ScoreData{SetsP1: 3, SetsP2: 0, GamesP1: []int{7, 6, 7}, GamesP2: []int{6, 3, 5}},
-			Date: time.Date(2023, 6, 11, 0, 0, 0, 0, time.UTC), DurationMins: 195,
-		},
-		{
-			TournamentID: "wimbledon-2023", Round: "F",
-			Player1Name: "C. Alcaraz", Player2Name: "N. Djokovic", WinnerName: "C. Alcaraz",
-			Score: ScoreData{SetsP1: 3, SetsP2: 2, GamesP1: []int{1, 7, 6, 3, 6}, GamesP2: []int{6, 6, 1, 6, 4}},
-			Date: time.Date(2023, 7, 16, 0, 0, 0, 0, time.UTC), DurationMins: 288,
-		},
-		{
-			TournamentID: "us-open-2023", Round: "F",
-			Player1Name: "N. Djokovic", Player2Name: "D. Medvedev", WinnerName: "N. Djokovic",
-			Score: ScoreData{SetsP1: 3, SetsP2: 0, GamesP1: []int{6, 7, 6}, GamesP2: []int{3, 6, 3}},
-			Date: time.Date(2023, 9, 10, 0, 0, 0, 0, time.UTC), DurationMins: 178,
-		},
-
-		// 2022 Grand Slam Finals
-		{
-			TournamentID: "aus-open-2022", Round: "F",
-			Player1Name: "R. Nadal", Player2Name: "D. Medvedev", WinnerName: "R. Nadal",
-			Score: ScoreData{SetsP1: 3, SetsP2: 2, GamesP1: []int{2, 6, 6, 6, 7}, GamesP2: []int{6, 7, 4, 4, 5}},
-			Date: time.Date(2022, 1, 30, 0, 0, 0, 0, time.UTC), DurationMins: 330,
-		},
-		{
-			TournamentID: "roland-garros-2022", Round: "F",
-			Player1Name: "R. Nadal", Player2Name: "C. Ruud", WinnerName: "R. Nadal",
-			Score: ScoreData{SetsP1: 3, SetsP2: 0, GamesP1: []int{6, 6, 6}, GamesP2: []int{3, 3, 0}},
-			Date: time.Date(2022, 6, 5, 0, 0, 0, 0, time.UTC), DurationMins: 135,
-		},
-		{
-			TournamentID: "wimbledon-2022", Round: "F",
-			Player1Name: "N. Djokovic", Player2Name: "N. Kyrgios", WinnerName: "N. Djokovic",
-			Score: ScoreData{SetsP1: 3, SetsP2: 1, GamesP1: []int{4, 6, 6, 7}, GamesP2: []int{6, 3, 4, 6}},
-			Date: time.Date(2022, 7, 10, 0, 0, 0, 0, time.UTC), DurationMins: 186,
-		},
-		{
-			TournamentID: "us-open-2022", Round: "F",
-			Player1Name: "C. Alcaraz", Player2Name: "C. Ruud", WinnerName: "C. Alcaraz",
-			Score: ScoreData{SetsP1: 3, SetsP2: 1, GamesP1: []int{6, 2, 7, 6}, GamesP2: []int{4, 6, 6, 3}},
-			Date: time.Date(2022, 9, 11, 0, 0, 0, 0, time.UTC), DurationMins: 215,
-		},
-
-		// 2021 Grand Slam Finals
-		{
-			TournamentID: "aus-open-2021", Round: "F",
-			Player1Name: "N. Djokovic", Player2Name: "D. Medvedev", WinnerName: "N. Djokovic",
-			Score: ScoreData{SetsP1: 3, SetsP2: 0, GamesP1: []int{7, 6, 7}, GamesP2: []int{5, 2, 5}},
-			Date: time.Date(2021, 2, 21, 0, 0, 0, 0, time.UTC), DurationMins: 113,
-		},
-		{
-			TournamentID: "roland-garros-2021", Round: "F",
-			Player1Name: "N. Djokovic", Player2Name: "S. Tsitsipas", WinnerName: "N. Djokovic",
-			Score: ScoreData{SetsP1: 3, SetsP2: 2, GamesP1: []int{6, 2, 6, 6, 6}, GamesP2: []int{7, 6, 3, 2, 4}},
-			Date: time.Date(2021, 6, 13, 0, 0, 0, 0, time.UTC), DurationMins: 255,
-		},
-		{
-			TournamentID: "wimbledon-2021", Round: "F",
-			Player1Name: "N. Djokovic", Player2Name: "M. Berrettini", WinnerName: "N. Djokovic",
-			Score: ScoreData{SetsP1: 3, SetsP2: 1, GamesP1: []int{6, 6, 6, 6}, GamesP2: []int{7, 4, 4, 3}},
-			Date: time.Date(2021, 7, 11, 0, 0, 0, 0, time.UTC), DurationMins: 205,
-		},
-		{
-			TournamentID: "us-open-2021", Round: "F",
-			Player1Name: "D. Medvedev", Player2Name: "N. Djokovic", WinnerName: "D. Medvedev",
-			Score: ScoreData{SetsP1: 3, SetsP2: 0, GamesP1: []int{6, 6, 6}, GamesP2: []int{4, 4, 4}},
-			Date: time.Date(2021, 9, 12, 0, 0, 0, 0, time.UTC), DurationMins: 135,
-		},
+	// Compare the result with what is stored
+	current, err := s.matchRepo.GetByID(ctx, matchID)
+	exists, err := stored(err)
+	if err != nil {
+		return row, err
+	}
+	var diff fieldDiff
+	if exists {
+		diff.compare("status", current.Status, match.Status)
+		diff.compare("winner_id", current.WinnerID, match.WinnerID)
+		diff.compare("round", current.Round, match.Round)
+		diff.compare("start_time", current.StartTime, match.StartTime)
+		diff.compare("sets_p1", current.Score.SetsP1, match.Score.SetsP1)
+		diff.compare("sets_p2", current.Score.SetsP2, match.Score.SetsP2)
+		diff.compare("sets", scoring.FormatSets(current.Sets), scoring.FormatSets(match.Sets))
+		diff.compare("duration_minutes", current.DurationMinutes, match.DurationMinutes)
+	}
+	row = rowChange("matches", matchID, matchData.Ref, exists, diff)
 
-		// 2020 Grand Slam Finals
-		{
-			TournamentID: "aus-open-2020", Round: "F",
-			Player1Name: "N. Djokovic", Player2Name: "D. Thiem", WinnerName: "N. Djokovic",
-			Score: ScoreData{SetsP1: 3, SetsP2: 2, GamesP1: []int{6, 4, 2, 6, 6}, GamesP2: []int{4, 6, 6, 4, 4}},
-			Date: time.Date(2020, 2, 2, 0, 0, 0, 0, time.UTC), DurationMins: 238,
-		},
-		{
-			TournamentID: "roland-garros-2020", Round: "F",
-			Player1Name: "R. Nadal", Player2Name: "N. Djokovic", WinnerName: "R. Nadal",
-			Score: ScoreData{SetsP1: 3, SetsP2: 0, GamesP1: []int{6, 6, 7}, GamesP2: []int{0, 2, 5}},
-			Date: time.Date(2020, 10, 11, 0, 0, 0, 0, time.UTC), DurationMins: 159,
-		},
-		{
-			TournamentID: "us-open-2020", Round: "F",
-			Player1Name: "D. Thiem", Player2Name: "A. Zverev", WinnerName: "D. Thiem",
-			Score: ScoreData{SetsP1: 3, SetsP2: 2, GamesP1: []int{2, 4, 6, 6, 7}, GamesP2: []int{6, 6, 4, 3, 6}},
-			Date: time.Date(2020, 9, 13, 0, 0, 0, 0, time.UTC), DurationMins: 251,
-		},
+	if s.writes(row) {
+		s.batch.Matches = append(s.batch.Matches, match)
 	}
+
+	return row, nil
 }
@@ -0,0 +1,188 @@
+package seeder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Action is what seeding does, or would do, with one row
+type Action string
+
+const (
+	ActionInsert    Action = "insert"
+	ActionUpdate    Action = "update"
+	ActionUnchanged Action = "unchanged"
+	ActionFailed    Action = "failed"
+)
+
+// FieldChange is one column a seed run changes in an existing row
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// RowChange is what seeding does with one row, and the record behind it
+type RowChange struct {
+	Table   string        `json:"table"`
+	ID      string        `json:"id"`
+	Action  Action        `json:"action"`
+	Changes []FieldChange `json:"changes,omitempty"`
+	Source  string        `json:"source,omitempty"` // Dataset record, or the record naming an unknown player
+	Error   string        `json:"error,omitempty"`
+}
+
+// TableSummary counts a table's rows by action
+type TableSummary struct {
+	Inserted  int `json:"inserted"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+	Failed    int `json:"failed"`
+}
+
+// Report is the diff between the datasets and the database: every row a
+// seed run wrote or, in a dry run, would write
+type Report struct {
+	DryRun      bool                     `json:"dry_run"`
+	GeneratedAt time.Time                `json:"generated_at"`
+	Tables      map[string]*TableSummary `json:"tables"`
+	Rows        []RowChange              `json:"rows"`
+}
+
+// NewReport creates an empty report
+func NewReport(dryRun bool) *Report {
+	return &Report{
+		DryRun:      dryRun,
+		GeneratedAt: time.Now().UTC(),
+		Tables:      make(map[string]*TableSummary),
+	}
+}
+
+// Add records a row and counts it against its table
+func (r *Report) Add(row RowChange) {
+	summary, ok := r.Tables[row.Table]
+	if !ok {
+		summary = &TableSummary{}
+		r.Tables[row.Table] = summary
+	}
+	switch row.Action {
+	case ActionInsert:
+		summary.Inserted++
+	case ActionUpdate:
+		summary.Updated++
+	case ActionUnchanged:
+		summary.Unchanged++
+	case ActionFailed:
+		summary.Failed++
+	}
+	r.Rows = append(r.Rows, row)
+}
+
+// failWrites marks the rows a failed write would have inserted or updated
+// as failed
+func (r *Report) failWrites(err error) {
+	for i, row := range r.Rows {
+		summary := r.Tables[row.Table]
+		switch row.Action {
+		case ActionInsert:
+			summary.Inserted--
+		case ActionUpdate:
+			summary.Updated--
+		default:
+			continue
+		}
+		summary.Failed++
+		r.Rows[i].Action = ActionFailed
+		r.Rows[i].Changes = nil
+		r.Rows[i].Error = err.Error()
+	}
+}
+
+// TableNames returns the tables in the report, sorted
+func (r *Report) TableNames() []string {
+	names := make([]string, 0, len(r.Tables))
+	for name := range r.Tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// String describes a row change on one line, e.g.
+// "~ matches aus-open-2024-j-sinner-vs-d-medvedev: sets 6-3 6-3 4-6 6-4 3-6 → 3-6 3-6 6-4 6-4 6-3"
+func (c RowChange) String() string {
+	switch c.Action {
+	case ActionInsert:
+		return fmt.Sprintf("+ %s %s", c.Table, c.ID)
+	case ActionFailed:
+		return fmt.Sprintf("✗ %s %s: %s", c.Table, c.ID, c.Error)
+	case ActionUnchanged:
+		return fmt.Sprintf("= %s %s", c.Table, c.ID)
+	}
+	fields := make([]string, len(c.Changes))
+	for i, change := range c.Changes {
+		fields[i] = fmt.Sprintf("%s %s → %s", change.Field, orNone(change.Old), orNone(change.New))
+	}
+	return fmt.Sprintf("~ %s %s: %s", c.Table, c.ID, strings.Join(fields, ", "))
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
+
+// fieldDiff collects the columns that differ between a stored row and the
+// row seeding writes
+type fieldDiff []FieldChange
+
+func (d *fieldDiff) compare(field string, current, seeded interface{}) {
+	old, updated := fieldValue(current), fieldValue(seeded)
+	if old != updated {
+		*d = append(*d, FieldChange{Field: field, Old: old, New: updated})
+	}
+}
+
+// fieldValue renders a column value for comparison and reports
+func fieldValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case *string:
+		if v == nil {
+			return ""
+		}
+		return *v
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return fieldValue(*v)
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.UTC().Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(v)
+}
+
+// rowChange turns a diff against a stored row into insert, update or unchanged
+func rowChange(table, id string, ref Ref, exists bool, diff fieldDiff) RowChange {
+	row := RowChange{Table: table, ID: id, Source: ref.String()}
+	switch {
+	case !exists:
+		row.Action = ActionInsert
+	case len(diff) > 0:
+		row.Action = ActionUpdate
+		row.Changes = diff
+	default:
+		row.Action = ActionUnchanged
+	}
+	return row
+}
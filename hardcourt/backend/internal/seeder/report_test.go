@@ -0,0 +1,79 @@
+package seeder
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+)
+
+func TestRowChange(t *testing.T) {
+	ref := Ref{File: "data/common/finals.json", Section: "matches", Index: 0}
+	winner, oldWinner := "j-sinner", "d-medvedev"
+
+	var diff fieldDiff
+	diff.compare("status", domain.StatusFinished, domain.StatusFinished)
+	diff.compare("winner_id", &oldWinner, &winner)
+	diff.compare("start_time", time.Date(2024, 1, 28, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 28, 0, 0, 0, 0, time.UTC))
+	diff.compare("duration_minutes", 0, 213)
+	diff.compare("seed", nil, (*string)(nil))
+
+	row := rowChange("matches", "m1", ref, true, diff)
+	if row.Action != ActionUpdate || len(row.Changes) != 2 {
+		t.Fatalf("Expected an update of 2 fields, got %+v", row)
+	}
+	if got := row.String(); got != "~ matches m1: winner_id d-medvedev → j-sinner, duration_minutes 0 → 213" {
+		t.Errorf("Unexpected description %q", got)
+	}
+	if row.Source != "data/common/finals.json: matches[0]" {
+		t.Errorf("Unexpected source %q", row.Source)
+	}
+
+	if row := rowChange("matches", "m1", ref, false, nil); row.Action != ActionInsert || row.String() != "+ matches m1" {
+		t.Errorf("Expected an insert, got %+v", row)
+	}
+	if row := rowChange("matches", "m1", ref, true, nil); row.Action != ActionUnchanged {
+		t.Errorf("Expected unchanged, got %+v", row)
+	}
+}
+
+func TestReport(t *testing.T) {
+	report := NewReport(true)
+	report.Add(RowChange{Table: "players", ID: "j-sinner", Action: ActionInsert})
+	report.Add(RowChange{Table: "players", ID: "c-alcaraz", Action: ActionUnchanged})
+	report.Add(RowChange{Table: "matches", ID: "m1", Action: ActionUpdate})
+	report.Add(RowChange{Table: "matches", ID: "m2", Action: ActionFailed, Error: "boom"})
+
+	if names := report.TableNames(); len(names) != 2 || names[0] != "matches" || names[1] != "players" {
+		t.Errorf("Unexpected tables %v", names)
+	}
+	if got := *report.Tables["players"]; got != (TableSummary{Inserted: 1, Unchanged: 1}) {
+		t.Errorf("Unexpected players summary %+v", got)
+	}
+	if got := *report.Tables["matches"]; got != (TableSummary{Updated: 1, Failed: 1}) {
+		t.Errorf("Unexpected matches summary %+v", got)
+	}
+	if len(report.Rows) != 4 || !report.DryRun {
+		t.Errorf("Expected 4 rows in a dry run report, got %d", len(report.Rows))
+	}
+}
+
+func TestReport_FailWrites(t *testing.T) {
+	report := NewReport(false)
+	report.Add(RowChange{Table: "players", ID: "j-sinner", Action: ActionInsert})
+	report.Add(RowChange{Table: "players", ID: "c-alcaraz", Action: ActionUnchanged})
+	report.Add(RowChange{Table: "matches", ID: "m1", Action: ActionUpdate, Changes: []FieldChange{{Field: "round", Old: "SF", New: "F"}}})
+
+	report.failWrites(errors.New("connection reset"))
+
+	if got := *report.Tables["players"]; got != (TableSummary{Unchanged: 1, Failed: 1}) {
+		t.Errorf("Unexpected players summary %+v", got)
+	}
+	if got := *report.Tables["matches"]; got != (TableSummary{Failed: 1}) {
+		t.Errorf("Unexpected matches summary %+v", got)
+	}
+	if row := report.Rows[2]; row.String() != "✗ matches m1: connection reset" || row.Changes != nil {
+		t.Errorf("Expected the update to be failed, got %+v", row)
+	}
+}
@@ -2,12 +2,15 @@ package seeder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
 	"hardcourt/backend/internal/domain"
 	"hardcourt/backend/internal/repository"
+
+	"github.com/jackc/pgx/v5"
 )
 
 // Service handles seeding of historical data
@@ -16,7 +19,13 @@ type Service struct {
 	playerRepo     *repository.PlayerRepository
 	matchRepo      *repository.MatchRepository
 	drawRepo       *repository.TournamentDrawRepository
-	playerCache    map[string]string // name -> player ID mapping
+	writer         *repository.BulkWriter
+	dataset        *Dataset
+	dryRun         bool                 // Diff against the database without writing
+	batch          repository.BulkBatch // Rows to write, stored together by Flush
+	report         *Report
+	playerCache    map[string]string                              // name -> player ID mapping
+	drawCache      map[string]map[drawSlot]*domain.TournamentDraw // tournament -> stored draw entries
 }
 
 // NewService creates a new seeder service
@@ -25,45 +34,100 @@ func NewService(
 	playerRepo *repository.PlayerRepository,
 	matchRepo *repository.MatchRepository,
 	drawRepo *repository.TournamentDrawRepository,
+	writer *repository.BulkWriter,
+	dataset *Dataset,
+	dryRun bool,
 ) *Service {
 	return &Service{
 		tournamentRepo: tournamentRepo,
 		playerRepo:     playerRepo,
 		matchRepo:      matchRepo,
 		drawRepo:       drawRepo,
+		writer:         writer,
+		dataset:        dataset,
+		dryRun:         dryRun,
+		report:         NewReport(dryRun),
+		batch:          repository.BulkBatch{ReplaceStartTimes: true},
 		playerCache:    make(map[string]string),
+		drawCache:      make(map[string]map[drawSlot]*domain.TournamentDraw),
+	}
+}
+
+// Report returns what seeding has written so far, or would write in a dry run
+func (s *Service) Report() *Report {
+	return s.report
+}
+
+// record adds a row to the report, as failed if seeding it returned an
+// error, and logs rows that change the database
+func (s *Service) record(row RowChange, err error) {
+	if err != nil {
+		row.Action = ActionFailed
+		row.Changes = nil
+		row.Error = err.Error()
+	}
+	if row.Action != ActionUnchanged {
+		log.Printf("  %s", row)
+	}
+	s.report.Add(row)
+}
+
+// writes reports whether a planned row is written: rows already up to date
+// are skipped, and a dry run writes nothing
+func (s *Service) writes(row RowChange) bool {
+	return !s.dryRun && row.Action != ActionUnchanged
+}
+
+// Flush writes the rows the Seed methods planned in one transaction, so a
+// run is stored entirely or not at all. If it fails, those rows are marked
+// failed in the report.
+func (s *Service) Flush(ctx context.Context) error {
+	if s.batch.Empty() {
+		return nil
+	}
+
+	start := time.Now()
+	result, err := s.writer.Write(ctx, &s.batch)
+	if err != nil {
+		s.report.failWrites(err)
+		return err
+	}
+	log.Printf("✓ Wrote %d players, %d tournaments, %d matches and %d draw entries in %s",
+		result.Players, result.Tournaments, result.MatchesInserted+result.MatchesUpdated, result.Draws,
+		time.Since(start).Round(time.Millisecond))
+
+	s.batch = repository.BulkBatch{ReplaceStartTimes: true}
+	return nil
+}
+
+// stored turns a lookup error into whether the row exists, keeping only
+// real failures as errors
+func stored(err error) (bool, error) {
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, pgx.ErrNoRows), errors.Is(err, repository.ErrMatchNotFound):
+		return false, nil
 	}
+	return false, err
 }
 
 // SeedTournaments populates the database with historical tournament data
-func (s *Service) SeedTournaments(ctx context.Context, comprehensive bool) error {
+func (s *Service) SeedTournaments(ctx context.Context) error {
 	log.Println("Starting tournament seeding...")
 
-	var tournaments []TournamentInfo
-	if comprehensive {
-		log.Println("Using MASSIVE dataset (Grand Slams, Masters 1000, ATP 500, ATP 250 + Real ATP Data)")
-		data := GetComprehensiveTournamentData()
-		tournaments = data.Tournaments
-
-		// Add real ATP tournaments from 2024
-		tournaments = append(tournaments, GetAdditionalTournaments2024()...)
-		// Add real ATP tournaments from 2023
-		tournaments = append(tournaments, GetAdditionalTournaments2023()...)
-
-		log.Printf("Total tournaments to seed: %d", len(tournaments))
-	} else {
-		log.Println("Using standard dataset (Grand Slams, Masters 1000)")
-		data := GetTournamentSeedData()
-		tournaments = data.Tournaments
-	}
+	tournaments := s.dataset.Tournaments
+	log.Printf("Total tournaments to seed: %d", len(tournaments))
 
 	successCount := 0
 	errorCount := 0
 
 	for _, tournamentInfo := range tournaments {
-		if err := s.seedSingleTournament(ctx, tournamentInfo); err != nil {
-			log.Printf("Warning: Failed to seed tournament %s (%s): %v",
-				tournamentInfo.Name, tournamentInfo.Year, err)
+		row, err := s.seedSingleTournament(ctx, tournamentInfo)
+		s.record(row, err)
+		if err != nil {
+			log.Printf("Warning: Failed to seed tournament %s (%d) from %s: %v",
+				tournamentInfo.Name, tournamentInfo.Year, tournamentInfo.Ref, err)
 			errorCount++
 		} else {
 			successCount++
@@ -80,22 +144,24 @@ func (s *Service) SeedTournaments(ctx context.Context, comprehensive bool) error
 }
 
 // seedSingleTournament seeds a single tournament with winner/runner-up data
-func (s *Service) seedSingleTournament(ctx context.Context, info TournamentInfo) error {
+func (s *Service) seedSingleTournament(ctx context.Context, info TournamentInfo) (RowChange, error) {
+	row := RowChange{Table: "tournaments", ID: info.ID, Source: info.Ref.String()}
+
 	// Resolve winner and runner-up player IDs
 	var winnerID, runnerUpID *string
 
 	if info.WinnerName != "" {
-		id, err := s.resolvePlayerID(ctx, info.WinnerName)
+		id, err := s.resolvePlayerID(ctx, info.WinnerName, info.Ref)
 		if err != nil {
-			return fmt.Errorf("failed to resolve winner %s: %w", info.WinnerName, err)
+			return row, fmt.Errorf("failed to resolve winner %s: %w", info.WinnerName, err)
 		}
 		winnerID = &id
 	}
 
 	if info.RunnerUpName != "" {
-		id, err := s.resolvePlayerID(ctx, info.RunnerUpName)
+		id, err := s.resolvePlayerID(ctx, info.RunnerUpName, info.Ref)
 		if err != nil {
-			return fmt.Errorf("failed to resolve runner-up %s: %w", info.RunnerUpName, err)
+			return row, fmt.Errorf("failed to resolve runner-up %s: %w", info.RunnerUpName, err)
 		}
 		runnerUpID = &id
 	}
@@ -107,8 +173,8 @@ func (s *Service) seedSingleTournament(ctx context.Context, info TournamentInfo)
 		Surface:    info.Surface,
 		City:       info.City,
 		Country:    info.Country,
-		StartDate:  &info.StartDate,
-		EndDate:    &info.EndDate,
+		StartDate:  &info.StartDate.Time,
+		EndDate:    &info.EndDate.Time,
 		Year:       info.Year,
 		Category:   info.Category,
 		PrizeMoney: info.PrizeMoney,
@@ -117,28 +183,50 @@ func (s *Service) seedSingleTournament(ctx context.Context, info TournamentInfo)
 		RunnerUpID: runnerUpID,
 	}
 
-	// Create or update tournament
-	if err := s.tournamentRepo.Create(ctx, tournament); err != nil {
-		return fmt.Errorf("failed to create tournament: %w", err)
+	// Compare the columns the bulk write merges with what is stored
+	current, err := s.tournamentRepo.GetByID(ctx, info.ID)
+	exists, err := stored(err)
+	if err != nil {
+		return row, err
+	}
+	var diff fieldDiff
+	if exists {
+		diff.compare("name", current.Name, tournament.Name)
+		diff.compare("surface", current.Surface, tournament.Surface)
+		diff.compare("city", current.City, tournament.City)
+		diff.compare("country", current.Country, tournament.Country)
+		diff.compare("category", current.Category, tournament.Category)
+		diff.compare("year", current.Year, tournament.Year)
+		diff.compare("start_date", current.StartDate, tournament.StartDate)
+		diff.compare("end_date", current.EndDate, tournament.EndDate)
 	}
+	row = rowChange("tournaments", info.ID, info.Ref, exists, diff)
 
-	log.Printf("✓ Seeded: %s %d (%s)", info.Name, info.Year, info.Category)
-	return nil
+	if s.writes(row) {
+		s.batch.Tournaments = append(s.batch.Tournaments, tournament)
+	}
+
+	return row, nil
 }
 
-// resolvePlayerID gets or creates a player ID from a player name
-func (s *Service) resolvePlayerID(ctx context.Context, playerName string) (string, error) {
+// resolvePlayerID gets or creates a player ID from a player name. A player
+// created here is recorded in the report against ref, the record naming them.
+func (s *Service) resolvePlayerID(ctx context.Context, playerName string, ref Ref) (string, error) {
 	// Check cache first
 	if id, exists := s.playerCache[playerName]; exists {
 		return id, nil
 	}
 
 	// Generate player ID from name (e.g., "J. Sinner" -> "j-sinner")
-	playerID := s.generatePlayerID(playerName)
+	playerID := domain.PlayerIDFromName(playerName)
 
 	// Check if player exists in database
-	player, err := s.playerRepo.GetByID(ctx, playerID)
-	if err == nil && player != nil {
+	_, err := s.playerRepo.GetByID(ctx, playerID)
+	exists, err := stored(err)
+	if err != nil {
+		return "", err
+	}
+	if exists {
 		// Player exists, cache and return
 		s.playerCache[playerName] = playerID
 		return playerID, nil
@@ -152,29 +240,17 @@ func (s *Service) resolvePlayerID(ctx context.Context, playerName string) (strin
 		Rank:        0, // Will be updated by real data later
 	}
 
-	if err := s.playerRepo.Create(ctx, newPlayer); err != nil {
-		// If error is duplicate, that's fine - another goroutine created it
-		if !strings.Contains(err.Error(), "duplicate") && !strings.Contains(err.Error(), "conflict") {
-			return "", fmt.Errorf("failed to create player: %w", err)
-		}
+	row := rowChange("players", playerID, ref, false, nil)
+	if s.writes(row) {
+		s.batch.Players = append(s.batch.Players, newPlayer)
 	}
+	s.record(row, nil)
 
 	// Cache and return
 	s.playerCache[playerName] = playerID
-	log.Printf("  → Created player: %s (ID: %s)", playerName, playerID)
 	return playerID, nil
 }
 
-// generatePlayerID creates a URL-safe player ID from name
-// Example: "J. Sinner" -> "j-sinner"
-func (s *Service) generatePlayerID(name string) string {
-	id := strings.ToLower(name)
-	id = strings.ReplaceAll(id, ".", "")
-	id = strings.ReplaceAll(id, " ", "-")
-	id = strings.TrimSpace(id)
-	return id
-}
-
 // extractCountryCode attempts to extract country code from player name
 // This is a placeholder - in production you'd have a proper player database
 func (s *Service) extractCountryCode(name string) string {
@@ -211,32 +287,10 @@ func (s *Service) extractCountryCode(name string) string {
 }
 
 // SeedPlayers creates ATP players with accurate data
-func (s *Service) SeedPlayers(ctx context.Context, comprehensive bool) error {
+func (s *Service) SeedPlayers(ctx context.Context) error {
 	log.Println("Seeding ATP players...")
 
-	var playerData []PlayerSeedData
-	if comprehensive {
-		log.Println("Using MASSIVE player dataset (Top 100 ATP rankings)")
-		playerData = GetMassiveATPPlayers()
-	} else {
-		// Basic dataset - top 12 + legends
-		playerData = []PlayerSeedData{
-			{ID: "j-sinner", Name: "J. Sinner", CountryCode: "IT", Rank: 1, Points: 11180, Age: 23, HeightCm: 193, Plays: "Right"},
-			{ID: "c-alcaraz", Name: "C. Alcaraz", CountryCode: "ES", Rank: 2, Points: 8500, Age: 21, HeightCm: 183, Plays: "Right"},
-			{ID: "n-djokovic", Name: "N. Djokovic", CountryCode: "RS", Rank: 3, Points: 7900, Age: 37, HeightCm: 188, Plays: "Right"},
-			{ID: "d-medvedev", Name: "D. Medvedev", CountryCode: "RU", Rank: 4, Points: 5000, Age: 28, HeightCm: 198, Plays: "Right"},
-			{ID: "a-zverev", Name: "A. Zverev", CountryCode: "DE", Rank: 5, Points: 4800, Age: 27, HeightCm: 198, Plays: "Right"},
-			{ID: "a-rublev", Name: "A. Rublev", CountryCode: "RU", Rank: 6, Points: 4100, Age: 27, HeightCm: 188, Plays: "Right"},
-			{ID: "h-rune", Name: "H. Rune", CountryCode: "DK", Rank: 7, Points: 3800, Age: 21, HeightCm: 193, Plays: "Right"},
-			{ID: "h-hurkacz", Name: "H. Hurkacz", CountryCode: "PL", Rank: 8, Points: 3500, Age: 27, HeightCm: 196, Plays: "Right"},
-			{ID: "t-fritz", Name: "T. Fritz", CountryCode: "US", Rank: 9, Points: 3200, Age: 27, HeightCm: 196, Plays: "Right"},
-			{ID: "s-tsitsipas", Name: "S. Tsitsipas", CountryCode: "GR", Rank: 10, Points: 3100, Age: 26, HeightCm: 193, Plays: "Right"},
-			{ID: "c-ruud", Name: "C. Ruud", CountryCode: "NO", Rank: 11, Points: 3000, Age: 26, HeightCm: 183, Plays: "Right"},
-			{ID: "g-dimitrov", Name: "G. Dimitrov", CountryCode: "BG", Rank: 12, Points: 2900, Age: 33, HeightCm: 191, Plays: "Right"},
-			{ID: "r-nadal", Name: "R. Nadal", CountryCode: "ES", Rank: 150, Points: 500, Age: 38, HeightCm: 185, Plays: "Left"},
-			{ID: "d-thiem", Name: "D. Thiem", CountryCode: "AT", Rank: 98, Points: 800, Age: 31, HeightCm: 185, Plays: "Right"},
-		}
-	}
+	playerData := s.dataset.Players
 
 	successCount := 0
 	for _, pd := range playerData {
@@ -251,11 +305,12 @@ func (s *Service) SeedPlayers(ctx context.Context, comprehensive bool) error {
 			Plays:       pd.Plays,
 		}
 
-		if err := s.playerRepo.Create(ctx, player); err != nil {
-			if !strings.Contains(err.Error(), "duplicate") && !strings.Contains(err.Error(), "conflict") {
-				log.Printf("Warning: Failed to seed player %s: %v", pd.Name, err)
-			}
+		row, err := s.seedSinglePlayer(ctx, player, pd.Ref)
+		s.record(row, err)
+		if err != nil {
+			log.Printf("Warning: Failed to seed player %s from %s: %v", pd.Name, pd.Ref, err)
 		} else {
+			s.playerCache[pd.Name] = pd.ID
 			successCount++
 		}
 	}
@@ -263,3 +318,26 @@ func (s *Service) SeedPlayers(ctx context.Context, comprehensive bool) error {
 	log.Printf("Player seeding complete: %d/%d successful", successCount, len(playerData))
 	return nil
 }
+
+// seedSinglePlayer plans inserting a player, or updating the columns Create
+// writes if they differ from what is stored
+func (s *Service) seedSinglePlayer(ctx context.Context, player *domain.Player, ref Ref) (RowChange, error) {
+	current, err := s.playerRepo.GetByID(ctx, player.ID)
+	exists, err := stored(err)
+	if err != nil {
+		return RowChange{Table: "players", ID: player.ID, Source: ref.String()}, err
+	}
+
+	var diff fieldDiff
+	if exists {
+		diff.compare("name", current.Name, player.Name)
+		diff.compare("country_code", current.CountryCode, player.CountryCode)
+		diff.compare("rank", current.Rank, player.Rank)
+	}
+	row := rowChange("players", player.ID, ref, exists, diff)
+
+	if s.writes(row) {
+		s.batch.Players = append(s.batch.Players, player)
+	}
+	return row, nil
+}
@@ -0,0 +1,161 @@
+package seeder
+
+import (
+	"fmt"
+	"regexp"
+
+	"hardcourt/backend/internal/domain"
+	"hardcourt/backend/internal/scoring"
+)
+
+// Values the dataset schema allows; see data/schema.json
+var (
+	validSurfaces   = map[string]bool{"Hard": true, "Clay": true, "Grass": true, "Carpet": true}
+	validCategories = map[string]bool{
+		"Grand Slam": true, "Masters 1000": true, "ATP 500": true, "ATP 250": true,
+		"ATP Finals": true, "ATP Cup": true, "Olympics": true, "Davis Cup": true, "Challenger": true,
+	}
+	validStatuses = map[string]bool{"": true, "upcoming": true, "ongoing": true, "completed": true}
+	validRounds   = map[string]bool{
+		"R128": true, "R64": true, "R32": true, "R16": true, "QF": true, "SF": true, "F": true,
+		"RR": true, "Q1": true, "Q2": true, "Q3": true, "BR": true,
+	}
+	validPlays = map[string]bool{"": true, "Right": true, "Left": true}
+
+	countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// Problem is a dataset record that breaks a rule
+type Problem struct {
+	Ref     Ref
+	Message string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s", p.Ref, p.Message)
+}
+
+// Validate checks every record against the dataset schema (required
+// fields, allowed values, unique IDs and references between records) and
+// the records against each other: legal scores that agree with the sets
+// and winner, one result per pairing, and consistent brackets. It returns
+// nil when the dataset is clean.
+func (d *Dataset) Validate() []Problem {
+	var problems []Problem
+	report := func(ref Ref, format string, args ...interface{}) {
+		problems = append(problems, Problem{Ref: ref, Message: fmt.Sprintf(format, args...)})
+	}
+
+	players := make(map[string]Ref)
+	for _, p := range d.Players {
+		switch {
+		case p.ID == "" || p.Name == "":
+			report(p.Ref, "player needs an id and a name")
+		case p.ID != domain.PlayerIDFromName(p.Name):
+			report(p.Ref, "player id %q does not match name %q, which gives %q", p.ID, p.Name, domain.PlayerIDFromName(p.Name))
+		}
+		if first, ok := players[p.ID]; ok {
+			report(p.Ref, "player %q is already defined at %s", p.ID, first)
+		} else {
+			players[p.ID] = p.Ref
+		}
+		if !countryCodePattern.MatchString(p.CountryCode) {
+			report(p.Ref, "country_code %q is not a two-letter code", p.CountryCode)
+		}
+		if !validPlays[p.Plays] {
+			report(p.Ref, "plays %q is not Right or Left", p.Plays)
+		}
+		if p.Rank < 0 || p.Points < 0 || p.Age < 0 || p.HeightCm < 0 {
+			report(p.Ref, "rank, points, age and height_cm cannot be negative")
+		}
+	}
+
+	tournaments := make(map[string]TournamentInfo)
+	for _, t := range d.Tournaments {
+		if t.ID == "" || t.Name == "" {
+			report(t.Ref, "tournament needs an id and a name")
+		}
+		if first, ok := tournaments[t.ID]; ok {
+			report(t.Ref, "tournament %q is already defined at %s", t.ID, first.Ref)
+		} else {
+			tournaments[t.ID] = t
+		}
+		if !validSurfaces[t.Surface] {
+			report(t.Ref, "surface %q is not Hard, Clay, Grass or Carpet", t.Surface)
+		}
+		if !validCategories[t.Category] {
+			report(t.Ref, "unknown category %q", t.Category)
+		}
+		if !validStatuses[t.Status] {
+			report(t.Ref, "unknown status %q", t.Status)
+		}
+		if t.StartDate.IsZero() || t.EndDate.IsZero() {
+			report(t.Ref, "tournament needs a start_date and an end_date")
+		} else if t.EndDate.Before(t.StartDate.Time) {
+			report(t.Ref, "end_date %s is before start_date %s", t.EndDate.Format(DateLayout), t.StartDate.Format(DateLayout))
+		}
+		if t.Year != t.EndDate.Year() && t.Year != t.StartDate.Year() {
+			report(t.Ref, "year %d is not the year of its dates", t.Year)
+		}
+	}
+
+	for _, m := range d.Matches {
+		t, ok := tournaments[m.TournamentID]
+		if !ok {
+			report(m.Ref, "unknown tournament %q", m.TournamentID)
+		}
+		if !validRounds[m.Round] {
+			report(m.Ref, "unknown round %q", m.Round)
+		}
+		switch {
+		case m.Player1Name == "" || m.Player2Name == "":
+			report(m.Ref, "match needs player1 and player2")
+		case m.Player1Name == m.Player2Name:
+			report(m.Ref, "%s cannot play themselves", m.Player1Name)
+		case m.WinnerName != m.Player1Name && m.WinnerName != m.Player2Name:
+			report(m.Ref, "winner %q is neither %s nor %s", m.WinnerName, m.Player1Name, m.Player2Name)
+		}
+		if m.Date.IsZero() {
+			report(m.Ref, "match needs a date")
+		}
+		if m.Score.Text == "" && (len(m.Score.GamesP1) == 0 || len(m.Score.GamesP1) != len(m.Score.GamesP2)) {
+			report(m.Ref, "score needs games_p1 and games_p2 of the same length, or a text score")
+		} else {
+			checkScore(report, m, setsToWin(t))
+		}
+		if m.DurationMins < 0 {
+			report(m.Ref, "duration_minutes cannot be negative")
+		}
+	}
+
+	for _, e := range d.Draws {
+		if _, ok := tournaments[e.TournamentID]; !ok {
+			report(e.Ref, "unknown tournament %q", e.TournamentID)
+		}
+		if !validRounds[e.Round] {
+			report(e.Ref, "unknown round %q", e.Round)
+		}
+		if e.Position < 1 {
+			report(e.Ref, "position must be 1 or more")
+		}
+		if e.Bye == (e.PlayerName != "") {
+			report(e.Ref, "draw entry needs either a player or bye")
+		}
+		if e.Seed < 0 {
+			report(e.Ref, "seed cannot be negative")
+		}
+	}
+
+	d.checkResults(report, tournaments)
+	d.checkBrackets(report)
+	return problems
+}
+
+// setsToWin returns the sets needed to win a match at a tournament: three
+// at Grand Slams, otherwise two, or 0 when the tournament is unknown
+func setsToWin(t TournamentInfo) int {
+	if t.ID == "" {
+		return 0
+	}
+	return scoring.SetsToWin(&domain.Match{Tournament: &domain.Tournament{Category: t.Category}})
+}
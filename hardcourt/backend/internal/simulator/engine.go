@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"strconv"
 	"time"
 
 	"hardcourt/backend/internal/domain"
 	"hardcourt/backend/internal/logic"
 	"hardcourt/backend/internal/repository"
+	"hardcourt/backend/internal/scoring"
 
 	"github.com/go-redis/redis/v8"
 )
@@ -20,17 +22,19 @@ type Engine struct {
 	math            *logic.MathEngine
 	matches         map[string]*domain.Match
 	updateChan      chan *domain.Match
+	eventChan       chan *domain.MatchEvent
 	matchRepo       *repository.MatchRepository
 	playerRepo      *repository.PlayerRepository
 	tournamentRepo  *repository.TournamentRepository
 }
 
-func NewEngine(rdb *redis.Client, updateChan chan *domain.Match, matchRepo *repository.MatchRepository, playerRepo *repository.PlayerRepository, tournamentRepo *repository.TournamentRepository) *Engine {
+func NewEngine(rdb *redis.Client, updateChan chan *domain.Match, eventChan chan *domain.MatchEvent, matchRepo *repository.MatchRepository, playerRepo *repository.PlayerRepository, tournamentRepo *repository.TournamentRepository) *Engine {
 	return &Engine{
 		rdb:            rdb,
 		math:           logic.NewMathEngine(),
 		matches:        make(map[string]*domain.Match),
 		updateChan:     updateChan,
+		eventChan:      eventChan,
 		matchRepo:      matchRepo,
 		playerRepo:     playerRepo,
 		tournamentRepo: tournamentRepo,
@@ -126,6 +130,8 @@ func (e *Engine) simulateRound() {
 			continue
 		}
 
+		prev := scoring.Snapshot(m)
+
 		// Simulate a point
 		winner := 1
 		if rand.Float64() > 0.5 {
@@ -155,9 +161,10 @@ func (e *Engine) simulateRound() {
 			m.Score.Serving,
 		)
 
-		isBreakPoint := (m.Score.Serving == 1 && m.Score.PointsP2 == "40") || (m.Score.Serving == 2 && m.Score.PointsP1 == "40")
-		isSetPoint := (m.Score.GamesP1 == 5 && m.Score.GamesP2 < 5) || (m.Score.GamesP2 == 5 && m.Score.GamesP1 < 5) // Simplified
-		isMatchPoint := (m.Score.SetsP1 == 2) || (m.Score.SetsP2 == 2)                                               // Simplified
+		setsToWin := scoring.SetsToWin(m)
+		isBreakPoint := scoring.IsBreakPoint(m.Score)
+		isSetPoint := scoring.IsSetPoint(m.Score, 1) || scoring.IsSetPoint(m.Score, 2)
+		isMatchPoint := scoring.IsMatchPoint(m.Score, 1, setsToWin) || scoring.IsMatchPoint(m.Score, 2, setsToWin)
 
 		m.LeverageIndex = e.math.CalculateLeverage(m.WinProbP1, isBreakPoint, isSetPoint, isMatchPoint)
 		m.FatigueP1 = e.math.CalculateFatigue(m.FatigueP1, m.Stats.RallyCount)
@@ -174,10 +181,24 @@ func (e *Engine) simulateRound() {
 
 		// Send to internal channel for WS
 		e.updateChan <- m
+
+		// Emit typed events for what this point changed
+		for _, ev := range scoring.Detect(&prev, m, time.Now()) {
+			ev := ev
+			if data, err := json.Marshal(ev); err == nil {
+				e.rdb.Publish(context.Background(), "match_events", data)
+			}
+			e.eventChan <- &ev
+		}
 	}
 }
 
 func (e *Engine) updateScore(m *domain.Match, winner int) {
+	if scoring.InTiebreak(m.Score) {
+		e.updateTiebreak(m, winner)
+		return
+	}
+
 	// Very basic tennis scoring state machine
 	points := []string{"0", "15", "30", "40", "AD"}
 
@@ -219,6 +240,35 @@ func (e *Engine) updateScore(m *domain.Match, winner int) {
 	}
 }
 
+// updateTiebreak plays a point of a tiebreak: first to 7, win by 2
+func (e *Engine) updateTiebreak(m *domain.Match, winner int) {
+	p1 := scoring.PointValue(m.Score.PointsP1, true)
+	p2 := scoring.PointValue(m.Score.PointsP2, true)
+	if winner == 1 {
+		p1++
+	} else {
+		p2++
+	}
+
+	if (p1 >= 7 || p2 >= 7) && (p1-p2 >= 2 || p2-p1 >= 2) {
+		if winner == 1 {
+			m.Score.GamesP1++
+		} else {
+			m.Score.GamesP2++
+		}
+		e.winSet(m, winner, p1, p2)
+		return
+	}
+
+	m.Score.PointsP1 = strconv.Itoa(p1)
+	m.Score.PointsP2 = strconv.Itoa(p2)
+
+	// Serve changes after the first point, then every two points
+	if (p1+p2)%2 == 1 {
+		m.Score.Serving = 3 - m.Score.Serving
+	}
+}
+
 func (e *Engine) winGame(m *domain.Match, winner int) {
 	m.Score.PointsP1 = "0"
 	m.Score.PointsP2 = "0"
@@ -236,14 +286,43 @@ func (e *Engine) winGame(m *domain.Match, winner int) {
 		m.Score.GamesP2++
 	}
 
-	// Set Logic (Simplified: first to 6)
+	// Set Logic: first to 6 by two, tiebreak at 6-6
 	if m.Score.GamesP1 >= 6 && m.Score.GamesP1 >= m.Score.GamesP2+2 {
-		m.Score.SetsP1++
-		m.Score.GamesP1 = 0
-		m.Score.GamesP2 = 0
+		e.winSet(m, 1, 0, 0)
 	} else if m.Score.GamesP2 >= 6 && m.Score.GamesP2 >= m.Score.GamesP1+2 {
+		e.winSet(m, 2, 0, 0)
+	}
+}
+
+// winSet records the finished set and ends the match once a player has enough sets
+func (e *Engine) winSet(m *domain.Match, winner int, tiebreakP1, tiebreakP2 int) {
+	m.Sets = append(m.Sets, domain.SetScore{
+		SetNumber:  m.Score.SetsP1 + m.Score.SetsP2 + 1,
+		GamesP1:    m.Score.GamesP1,
+		GamesP2:    m.Score.GamesP2,
+		TiebreakP1: tiebreakP1,
+		TiebreakP2: tiebreakP2,
+	})
+
+	if winner == 1 {
+		m.Score.SetsP1++
+	} else {
 		m.Score.SetsP2++
-		m.Score.GamesP1 = 0
-		m.Score.GamesP2 = 0
+	}
+	m.Score.GamesP1 = 0
+	m.Score.GamesP2 = 0
+	m.Score.PointsP1 = "0"
+	m.Score.PointsP2 = "0"
+
+	setsToWin := scoring.SetsToWin(m)
+	if m.Score.SetsP1 == setsToWin || m.Score.SetsP2 == setsToWin {
+		winnerID := m.Player1ID
+		if winner == 2 {
+			winnerID = m.Player2ID
+		}
+		now := time.Now()
+		m.Status = domain.StatusFinished
+		m.WinnerID = &winnerID
+		m.EndTime = &now
 	}
 }
@@ -0,0 +1,311 @@
+// Package vcr records provider HTTP traffic to cassettes and replays it, so
+// scrapers can be developed and the server run against a captured day
+// without network access.
+package vcr
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mode selects what the transport does with requests
+type Mode string
+
+const (
+	// Passthrough sends requests to the network untouched
+	Passthrough Mode = "passthrough"
+	// Record sends requests to the network and appends each response to a cassette
+	Record Mode = "record"
+	// Replay answers requests from cassettes and never touches the network
+	Replay Mode = "replay"
+)
+
+// DefaultDir is where cassettes are kept when no directory is configured
+const DefaultDir = "cassettes"
+
+// Config selects the mode and cassette directory
+type Config struct {
+	Mode Mode
+	Dir  string
+}
+
+// ConfigFromEnv reads HTTP_CASSETTE_MODE (passthrough, record or replay;
+// default passthrough) and HTTP_CASSETTE_DIR
+func ConfigFromEnv(getenv func(string) string) (Config, error) {
+	config := Config{Mode: Mode(strings.ToLower(getenv("HTTP_CASSETTE_MODE"))), Dir: getenv("HTTP_CASSETTE_DIR")}
+	switch config.Mode {
+	case "":
+		config.Mode = Passthrough
+	case Passthrough, Record, Replay:
+	default:
+		return Config{}, fmt.Errorf("unknown HTTP_CASSETTE_MODE %q", config.Mode)
+	}
+	if config.Dir == "" {
+		config.Dir = DefaultDir
+	}
+	return config, nil
+}
+
+// Interaction is one recorded request and its response
+type Interaction struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	Status     int         `json:"status"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+	RecordedAt time.Time   `json:"recorded_at"`
+}
+
+// Recorder owns the cassettes. One recorder can wrap the transports of
+// several providers.
+type Recorder struct {
+	config Config
+
+	mu        sync.Mutex
+	cassettes map[string]*cassette // by file path
+}
+
+// cassette holds every interaction for one method and URL, in order
+type cassette struct {
+	interactions []Interaction
+	next         int // replay position
+	loaded       bool
+}
+
+// New creates a recorder for config
+func New(config Config) *Recorder {
+	return &Recorder{config: config, cassettes: make(map[string]*cassette)}
+}
+
+// Mode returns the configured mode
+func (r *Recorder) Mode() Mode { return r.config.Mode }
+
+// Wrap returns a RoundTripper applying the recorder's mode in front of next
+func (r *Recorder) Wrap(next http.RoundTripper) http.RoundTripper {
+	if r.config.Mode == Passthrough {
+		return next
+	}
+	return &transport{recorder: r, next: next}
+}
+
+// Clock returns the time providers run at. In replay mode the clock starts
+// at the earliest recorded interaction and moves on with the wall clock, so
+// requests built from the date, like a day's schedule, ask for the recorded
+// day's URLs. Otherwise it is time.Now.
+func (r *Recorder) Clock() (func() time.Time, error) {
+	if r.config.Mode != Replay {
+		return time.Now, nil
+	}
+	start, err := r.recordedFrom()
+	if err != nil {
+		return nil, err
+	}
+	if start.IsZero() {
+		return time.Now, nil
+	}
+	offset := time.Since(start)
+	return func() time.Time { return time.Now().Add(-offset) }, nil
+}
+
+// recordedFrom returns when the earliest interaction in the cassette
+// directory was recorded, or the zero time if there are none
+func (r *Recorder) recordedFrom() (time.Time, error) {
+	var earliest time.Time
+	err := filepath.WalkDir(r.config.Dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".jsonl" {
+			return nil
+		}
+		interactions, err := load(path)
+		if err != nil {
+			return err
+		}
+		for _, interaction := range interactions {
+			if earliest.IsZero() || interaction.RecordedAt.Before(earliest) {
+				earliest = interaction.RecordedAt
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to read cassettes: %w", err)
+	}
+	return earliest, nil
+}
+
+type transport struct {
+	recorder *Recorder
+	next     http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.recorder.config.Mode == Replay {
+		return t.recorder.replay(req)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response for recording: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	interaction := Interaction{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		Status:     resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       string(body),
+		RecordedAt: time.Now().UTC(),
+	}
+	if err := t.recorder.record(interaction); err != nil {
+		// Recording is a development aid; never fail the scrape over it
+		log.Printf("📼 Failed to record %s %s: %v", req.Method, req.URL, err)
+	}
+	return resp, nil
+}
+
+// record appends an interaction to its cassette file
+func (r *Recorder) record(interaction Interaction) error {
+	path := r.path(interaction.Method, interaction.URL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette dir: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open cassette: %w", err)
+	}
+	defer f.Close()
+
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		return fmt.Errorf("failed to encode interaction: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+// replay answers a request with the next recorded interaction for its URL.
+// Once a cassette runs out its last interaction is repeated, so a replayed
+// match stays at its final recorded state.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	path := r.path(req.Method, req.URL.String())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.cassettes[path]
+	if !ok {
+		c = &cassette{}
+		r.cassettes[path] = c
+	}
+	if !c.loaded {
+		interactions, err := load(path)
+		if err != nil {
+			return nil, err
+		}
+		c.interactions, c.loaded = interactions, true
+	}
+	if len(c.interactions) == 0 {
+		return nil, fmt.Errorf("vcr: no recording for %s %s", req.Method, req.URL)
+	}
+
+	interaction := c.interactions[c.next]
+	if c.next < len(c.interactions)-1 {
+		c.next++
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Status, http.StatusText(interaction.Status)),
+		StatusCode:    interaction.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        interaction.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(interaction.Body)),
+		ContentLength: int64(len(interaction.Body)),
+		Request:       req,
+	}, nil
+}
+
+// load reads every interaction in a cassette file; a missing file is empty
+func load(path string) ([]Interaction, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cassette: %w", err)
+	}
+	defer f.Close()
+
+	var interactions []Interaction
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var interaction Interaction
+		if err := json.Unmarshal(scanner.Bytes(), &interaction); err != nil {
+			return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+		}
+		interactions = append(interactions, interaction)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read cassette %s: %w", path, err)
+	}
+	return interactions, nil
+}
+
+// path returns the cassette file for a request: one file per host, named
+// after the URL path plus a hash of the full method and URL, e.g.
+// cassettes/api.sofascore.com/api-v1-sport-tennis-events-live-1a2b3c4d.jsonl
+func (r *Recorder) path(method, rawURL string) string {
+	host, urlPath := "unknown", ""
+	if u, err := url.Parse(rawURL); err == nil {
+		host, urlPath = strings.ReplaceAll(u.Host, ":", "_"), u.Path
+	}
+
+	slug := strings.Trim(strings.Map(func(c rune) rune {
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '.' || c == '_' {
+			return c
+		}
+		return '-'
+	}, urlPath), "-")
+	if len(slug) > 80 {
+		slug = slug[:80]
+	}
+
+	sum := sha1.Sum([]byte(method + " " + rawURL))
+	name := hex.EncodeToString(sum[:4]) + ".jsonl"
+	if slug != "" {
+		name = slug + "-" + name
+	}
+	return filepath.Join(r.config.Dir, host, name)
+}
@@ -0,0 +1,119 @@
+package vcr
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func get(t *testing.T, client *http.Client, url string) (int, string) {
+	t.Helper()
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s failed: %v", url, err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestRecordThenReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, "poll %d", calls)
+	}))
+
+	recorder := New(Config{Mode: Record, Dir: dir})
+	client := &http.Client{Transport: recorder.Wrap(http.DefaultTransport)}
+	for i := 1; i <= 2; i++ {
+		if _, body := get(t, client, server.URL+"/live"); body != fmt.Sprintf("poll %d", i) {
+			t.Fatalf("Expected recording to pass responses through, got %q", body)
+		}
+	}
+	get(t, client, server.URL+"/missing")
+	server.Close()
+
+	// Replay never reaches the (now closed) server
+	replayer := New(Config{Mode: Replay, Dir: dir})
+	client = &http.Client{Transport: replayer.Wrap(http.DefaultTransport)}
+
+	for _, want := range []string{"poll 1", "poll 2", "poll 2"} {
+		if _, body := get(t, client, server.URL+"/live"); body != want {
+			t.Errorf("Expected %q, got %q", want, body)
+		}
+	}
+	if status, _ := get(t, client, server.URL+"/missing"); status != http.StatusNotFound {
+		t.Errorf("Expected the recorded 404, got %d", status)
+	}
+	if _, err := client.Get(server.URL + "/never-recorded"); err == nil {
+		t.Error("Expected an error for a request with no recording")
+	}
+}
+
+func TestReplayClock(t *testing.T) {
+	dir := t.TempDir()
+	recorder := New(Config{Mode: Record, Dir: dir})
+	recordedAt := time.Date(2025, 6, 7, 13, 0, 0, 0, time.UTC)
+	for i, url := range []string{"https://api.example.com/live", "https://api.example.com/scheduled-events/2025-06-07"} {
+		interaction := Interaction{Method: "GET", URL: url, Status: 200, RecordedAt: recordedAt.Add(time.Duration(i) * time.Hour)}
+		if err := recorder.record(interaction); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Replay runs from the start of the recording
+	now, err := New(Config{Mode: Replay, Dir: dir}).Clock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := now(); got.Before(recordedAt) || got.Sub(recordedAt) > time.Minute {
+		t.Errorf("Expected the replay clock to start at %s, got %s", recordedAt, got)
+	}
+
+	// Recording, and replaying nothing, run on the wall clock
+	for _, config := range []Config{{Mode: Record, Dir: dir}, {Mode: Replay, Dir: t.TempDir() + "/none"}} {
+		now, err := New(config).Clock()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if time.Since(now()) > time.Minute {
+			t.Errorf("%s: expected the wall clock, got %s", config.Mode, now())
+		}
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	env := map[string]string{}
+	getenv := func(key string) string { return env[key] }
+
+	config, err := ConfigFromEnv(getenv)
+	if err != nil || config.Mode != Passthrough || config.Dir != DefaultDir {
+		t.Errorf("Expected passthrough into %s by default, got %+v (%v)", DefaultDir, config, err)
+	}
+
+	env["HTTP_CASSETTE_MODE"] = "Replay"
+	env["HTTP_CASSETTE_DIR"] = "/tmp/rg-saturday"
+	if config, _ := ConfigFromEnv(getenv); config.Mode != Replay || config.Dir != "/tmp/rg-saturday" {
+		t.Errorf("Expected replay from /tmp/rg-saturday, got %+v", config)
+	}
+
+	env["HTTP_CASSETTE_MODE"] = "rewind"
+	if _, err := ConfigFromEnv(getenv); err == nil {
+		t.Error("Expected an error for an unknown mode")
+	}
+
+	// Passthrough leaves the transport alone
+	if New(Config{Mode: Passthrough}).Wrap(http.DefaultTransport) != http.DefaultTransport {
+		t.Error("Expected passthrough to return the wrapped transport")
+	}
+}
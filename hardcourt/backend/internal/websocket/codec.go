@@ -0,0 +1,264 @@
+package websocket
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Websocket subprotocols for encoding negotiation. JSON is the default when
+// the client asks for neither.
+const (
+	SubprotocolJSON     = "hardcourt.v1.json"
+	SubprotocolProtobuf = "hardcourt.v1.protobuf"
+)
+
+// Codec encodes hub messages for the wire
+type Codec interface {
+	Name() string
+	Binary() bool
+	Encode(msg *Message) ([]byte, error)
+}
+
+var (
+	jsonCodec     Codec = jsonEncoding{}
+	protobufCodec Codec = protobufEncoding{}
+)
+
+// negotiateCodec picks the encoding for a websocket client. The ?encoding=
+// query parameter wins over the negotiated subprotocol.
+func negotiateCodec(r *http.Request, subprotocol string) Codec {
+	switch r.URL.Query().Get("encoding") {
+	case "protobuf", "proto":
+		return protobufCodec
+	case "json":
+		return jsonCodec
+	}
+	if subprotocol == SubprotocolProtobuf {
+		return protobufCodec
+	}
+	return jsonCodec
+}
+
+type jsonEncoding struct{}
+
+func (jsonEncoding) Name() string                        { return "json" }
+func (jsonEncoding) Binary() bool                        { return false }
+func (jsonEncoding) Encode(msg *Message) ([]byte, error) { return json.Marshal(msg) }
+
+// protobufEncoding writes messages in the wire format described by
+// proto/live.proto. It is hand-rolled on protowire so the server does not
+// need generated code; clients generate theirs from the schema.
+type protobufEncoding struct{}
+
+func (protobufEncoding) Name() string { return "protobuf" }
+func (protobufEncoding) Binary() bool { return true }
+
+func (protobufEncoding) Encode(msg *Message) ([]byte, error) {
+	var b []byte
+	b = appendUint(b, 1, msg.ID)
+	b = appendString(b, 2, msg.Type)
+	if msg.Match != nil {
+		b = appendMessage(b, 3, appendMatch(nil, msg.Match))
+	}
+	if msg.Event != nil {
+		b = appendMessage(b, 4, appendEvent(nil, msg.Event))
+	}
+	if msg.Presence != nil {
+		b = appendMessage(b, 5, appendPresence(nil, msg.Presence))
+	}
+	return b, nil
+}
+
+func appendMatch(b []byte, m *domain.Match) []byte {
+	b = appendString(b, 1, m.ID)
+	b = appendString(b, 2, m.TournamentID)
+	if t := m.Tournament; t != nil {
+		var tb []byte
+		tb = appendString(tb, 1, t.ID)
+		tb = appendString(tb, 2, t.Name)
+		tb = appendString(tb, 3, t.Surface)
+		tb = appendString(tb, 4, t.City)
+		tb = appendString(tb, 5, t.Country)
+		tb = appendString(tb, 6, t.Category)
+		tb = appendInt(tb, 7, t.Year)
+		tb = appendString(tb, 8, t.Status)
+		tb = appendString(tb, 9, t.Source)
+		tb = appendString(tb, 10, t.ExternalID)
+		tb = appendString(tb, 11, t.SeasonID)
+		b = appendMessage(b, 3, tb)
+	}
+	b = appendString(b, 4, m.Player1ID)
+	b = appendString(b, 5, m.Player2ID)
+	if m.Player1 != nil {
+		b = appendMessage(b, 6, appendPlayer(nil, m.Player1))
+	}
+	if m.Player2 != nil {
+		b = appendMessage(b, 7, appendPlayer(nil, m.Player2))
+	}
+	b = appendString(b, 8, string(m.Status))
+	b = appendString(b, 9, m.Round)
+	b = appendTime(b, 10, m.StartTime)
+	if m.EndTime != nil {
+		b = appendTime(b, 11, *m.EndTime)
+	}
+	if m.WinnerID != nil {
+		b = appendString(b, 12, *m.WinnerID)
+	}
+	b = appendInt(b, 13, m.DurationMinutes)
+	b = appendString(b, 14, m.Court)
+	if m.IsSimulated {
+		b = appendUint(b, 15, 1)
+	}
+	b = appendMessage(b, 16, appendScore(nil, m.Score))
+	b = appendMessage(b, 17, appendStats(nil, m.Stats))
+	for _, set := range m.Sets {
+		b = appendMessage(b, 18, appendSet(nil, set))
+	}
+	b = appendDouble(b, 19, m.WinProbP1)
+	b = appendDouble(b, 20, m.LeverageIndex)
+	b = appendDouble(b, 21, m.FatigueP1)
+	b = appendDouble(b, 22, m.FatigueP2)
+	b = appendInt(b, 23, m.CourtOrder)
+	b = appendString(b, 24, m.NotBefore)
+	b = appendInt(b, 25, m.SeedP1)
+	b = appendInt(b, 26, m.SeedP2)
+	return b
+}
+
+func appendEvent(b []byte, ev *domain.MatchEvent) []byte {
+	b = appendString(b, 1, string(ev.Type))
+	b = appendString(b, 2, ev.MatchID)
+	b = appendString(b, 3, ev.TournamentID)
+	b = appendInt(b, 4, ev.Player)
+	b = appendInt(b, 5, ev.SetNumber)
+	if ev.Set != nil {
+		b = appendMessage(b, 6, appendSet(nil, *ev.Set))
+	}
+	b = appendString(b, 7, string(ev.FromStatus))
+	b = appendString(b, 8, string(ev.ToStatus))
+	b = appendMessage(b, 9, appendScore(nil, ev.Score))
+	b = appendTime(b, 10, ev.Timestamp)
+	return b
+}
+
+func appendPresence(b []byte, p *Presence) []byte {
+	b = appendCounts(b, 1, p.Matches)
+	b = appendCounts(b, 2, p.Tournaments)
+	b = appendInt(b, 3, int(p.Total))
+	b = appendTime(b, 4, p.UpdatedAt)
+	b = appendInt(b, 5, int(p.Unfiltered))
+	b = appendCounts(b, 6, p.Overlap)
+	return b
+}
+
+// appendCounts writes a map<string, int64> as repeated key/value entries in key order
+func appendCounts(b []byte, num protowire.Number, counts map[string]int64) []byte {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		var entry []byte
+		entry = appendString(entry, 1, key)
+		entry = appendInt(entry, 2, int(counts[key]))
+		b = appendMessage(b, num, entry)
+	}
+	return b
+}
+
+func appendPlayer(b []byte, p *domain.Player) []byte {
+	b = appendString(b, 1, p.ID)
+	b = appendString(b, 2, p.Name)
+	b = appendString(b, 3, p.CountryCode)
+	b = appendInt(b, 4, p.Rank)
+	b = appendInt(b, 5, p.Points)
+	return b
+}
+
+func appendScore(b []byte, s domain.ScoreState) []byte {
+	b = appendInt(b, 1, s.SetsP1)
+	b = appendInt(b, 2, s.SetsP2)
+	b = appendInt(b, 3, s.GamesP1)
+	b = appendInt(b, 4, s.GamesP2)
+	b = appendString(b, 5, s.PointsP1)
+	b = appendString(b, 6, s.PointsP2)
+	b = appendInt(b, 7, s.Serving)
+	return b
+}
+
+func appendStats(b []byte, s domain.MatchStats) []byte {
+	b = appendInt(b, 1, s.AcesP1)
+	b = appendInt(b, 2, s.AcesP2)
+	b = appendInt(b, 3, s.DoubleFaultsP1)
+	b = appendInt(b, 4, s.DoubleFaultsP2)
+	b = appendInt(b, 5, s.BreakPointsP1)
+	b = appendInt(b, 6, s.BreakPointsP2)
+	b = appendInt(b, 7, s.WinnersP1)
+	b = appendInt(b, 8, s.WinnersP2)
+	b = appendInt(b, 9, s.UnforcedErrorsP1)
+	b = appendInt(b, 10, s.UnforcedErrorsP2)
+	b = appendDouble(b, 11, s.FirstServePctP1)
+	b = appendDouble(b, 12, s.FirstServePctP2)
+	b = appendInt(b, 13, s.RallyCount)
+	return b
+}
+
+func appendSet(b []byte, s domain.SetScore) []byte {
+	b = appendInt(b, 1, s.SetNumber)
+	b = appendInt(b, 2, s.GamesP1)
+	b = appendInt(b, 3, s.GamesP2)
+	b = appendInt(b, 4, s.TiebreakP1)
+	b = appendInt(b, 5, s.TiebreakP2)
+	return b
+}
+
+// The helpers below follow proto3 semantics: zero values are not written.
+
+func appendString(b []byte, num protowire.Number, v string) []byte {
+	if v == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, v)
+}
+
+func appendUint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendInt(b []byte, num protowire.Number, v int) []byte {
+	// int32/int64 negatives are sign-extended to 64 bits on the wire
+	return appendUint(b, num, uint64(int64(v)))
+}
+
+func appendDouble(b []byte, num protowire.Number, v float64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.Fixed64Type)
+	return protowire.AppendFixed64(b, math.Float64bits(v))
+}
+
+func appendTime(b []byte, num protowire.Number, t time.Time) []byte {
+	if t.IsZero() {
+		return b
+	}
+	return appendInt(b, num, int(t.UnixMilli()))
+}
+
+func appendMessage(b []byte, num protowire.Number, m []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, m)
+}
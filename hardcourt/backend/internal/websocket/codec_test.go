@@ -0,0 +1,209 @@
+package websocket
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// fields decodes one level of a protobuf message into field number -> raw values
+func fields(t *testing.T, b []byte) map[protowire.Number][]interface{} {
+	t.Helper()
+	out := make(map[protowire.Number][]interface{})
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			t.Fatalf("Bad tag: %v", protowire.ParseError(n))
+		}
+		b = b[n:]
+		var v interface{}
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed64Type:
+			var bits uint64
+			bits, n = protowire.ConsumeFixed64(b)
+			v = math.Float64frombits(bits)
+		case protowire.BytesType:
+			v, n = protowire.ConsumeBytes(b)
+		default:
+			t.Fatalf("Unexpected wire type %v for field %d", typ, num)
+		}
+		if n < 0 {
+			t.Fatalf("Bad value for field %d: %v", num, protowire.ParseError(n))
+		}
+		b = b[n:]
+		out[num] = append(out[num], v)
+	}
+	return out
+}
+
+func TestProtobufCodec_Match(t *testing.T) {
+	start := time.UnixMilli(1737000000000)
+	msg := &Message{
+		ID:   7,
+		Type: MessageMatchUpdate,
+		Match: &domain.Match{
+			ID:           "sofa_1",
+			TournamentID: "aus-open-2025",
+			Status:       domain.StatusLive,
+			StartTime:    start,
+			Score:        domain.ScoreState{SetsP1: 1, GamesP2: 3, PointsP1: "AD", Serving: 1},
+			Sets:         []domain.SetScore{{SetNumber: 1, GamesP1: 7, GamesP2: 6, TiebreakP1: 7, TiebreakP2: 5}},
+			WinProbP1:    0.62,
+		},
+	}
+
+	data, err := protobufCodec.Encode(msg)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	env := fields(t, data)
+	if env[1][0].(uint64) != 7 || string(env[2][0].([]byte)) != MessageMatchUpdate {
+		t.Errorf("Unexpected envelope header: %v", env)
+	}
+	if _, ok := env[4]; ok {
+		t.Error("Expected no event field on a match update")
+	}
+
+	match := fields(t, env[3][0].([]byte))
+	if string(match[1][0].([]byte)) != "sofa_1" || string(match[8][0].([]byte)) != string(domain.StatusLive) {
+		t.Errorf("Unexpected match fields: %v", match)
+	}
+	if int64(match[10][0].(uint64)) != start.UnixMilli() {
+		t.Errorf("Expected start time %d, got %v", start.UnixMilli(), match[10])
+	}
+	if match[19][0].(float64) != 0.62 {
+		t.Errorf("Expected win probability 0.62, got %v", match[19])
+	}
+
+	score := fields(t, match[16][0].([]byte))
+	if score[1][0].(uint64) != 1 || string(score[5][0].([]byte)) != "AD" {
+		t.Errorf("Unexpected score fields: %v", score)
+	}
+	if _, ok := score[2]; ok {
+		t.Error("Expected zero sets_p2 to be omitted")
+	}
+
+	if len(match[18]) != 1 {
+		t.Fatalf("Expected one set, got %d", len(match[18]))
+	}
+	set := fields(t, match[18][0].([]byte))
+	if set[2][0].(uint64) != 7 || set[5][0].(uint64) != 5 {
+		t.Errorf("Unexpected set fields: %v", set)
+	}
+}
+
+func TestProtobufCodec_ScheduleAndProvenance(t *testing.T) {
+	match := &domain.Match{
+		ID:           "sofa_2",
+		TournamentID: "aus-open-2025",
+		Tournament: &domain.Tournament{
+			ID: "aus-open-2025", Name: "Australian Open",
+			Source: "sofascore", ExternalID: "2363", SeasonID: "65423",
+		},
+		Status:     domain.StatusScheduled,
+		Court:      "Rod Laver Arena",
+		CourtOrder: 3,
+		NotBefore:  "19:00",
+		SeedP1:     1,
+		SeedP2:     12,
+	}
+	data, err := protobufCodec.Encode(&Message{Type: MessageMatchUpdate, Match: match})
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	m := fields(t, fields(t, data)[3][0].([]byte))
+	decoded := domain.Match{
+		Court:      string(m[14][0].([]byte)),
+		CourtOrder: int(m[23][0].(uint64)),
+		NotBefore:  string(m[24][0].([]byte)),
+		SeedP1:     int(m[25][0].(uint64)),
+		SeedP2:     int(m[26][0].(uint64)),
+	}
+	if decoded.Court != match.Court || decoded.CourtOrder != match.CourtOrder || decoded.NotBefore != match.NotBefore ||
+		decoded.SeedP1 != match.SeedP1 || decoded.SeedP2 != match.SeedP2 {
+		t.Errorf("Order of play and seeds did not round-trip: got %+v", decoded)
+	}
+
+	tf := fields(t, m[3][0].([]byte))
+	tournament := domain.Tournament{
+		Source:     string(tf[9][0].([]byte)),
+		ExternalID: string(tf[10][0].([]byte)),
+		SeasonID:   string(tf[11][0].([]byte)),
+	}
+	if tournament.Source != "sofascore" || tournament.ExternalID != "2363" || tournament.SeasonID != "65423" {
+		t.Errorf("Tournament provenance did not round-trip: got %+v", tournament)
+	}
+
+	// Unseeded players and matches without an order of play send nothing
+	data, _ = protobufCodec.Encode(&Message{Type: MessageMatchUpdate, Match: &domain.Match{ID: "sofa_3"}})
+	m = fields(t, fields(t, data)[3][0].([]byte))
+	for _, num := range []protowire.Number{23, 24, 25, 26} {
+		if _, ok := m[num]; ok {
+			t.Errorf("Expected zero field %d to be omitted", num)
+		}
+	}
+}
+
+func TestHub_NegotiatesProtobuf(t *testing.T) {
+	hub, url := startHub(t)
+
+	dialer := websocket.Dialer{Subprotocols: []string{SubprotocolProtobuf}}
+	conn, _, err := dialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	defer conn.Close()
+
+	if conn.Subprotocol() != SubprotocolProtobuf {
+		t.Errorf("Expected %s subprotocol, got %q", SubprotocolProtobuf, conn.Subprotocol())
+	}
+	waitFor(t, func() bool { return hub.Metrics().ConnectedClients == 1 })
+
+	hub.BroadcastEvent(&domain.MatchEvent{Type: domain.EventSetWon, MatchID: "m1", Player: 2})
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	frameType, data, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("Failed to read message: %v", err)
+	}
+	if frameType != websocket.BinaryMessage {
+		t.Errorf("Expected a binary frame, got type %d", frameType)
+	}
+
+	env := fields(t, data)
+	event := fields(t, env[4][0].([]byte))
+	if string(event[1][0].([]byte)) != string(domain.EventSetWon) || event[4][0].(uint64) != 2 {
+		t.Errorf("Unexpected event fields: %v", event)
+	}
+}
+
+func TestHub_EncodingQueryOverridesDefault(t *testing.T) {
+	hub, url := startHub(t)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?encoding=protobuf", nil)
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	defer conn.Close()
+	waitFor(t, func() bool { return hub.Metrics().ConnectedClients == 1 })
+
+	hub.BroadcastMatchUpdate(&domain.Match{ID: "m1"})
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	frameType, _, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("Failed to read message: %v", err)
+	}
+	if frameType != websocket.BinaryMessage {
+		t.Errorf("Expected a binary frame, got type %d", frameType)
+	}
+}
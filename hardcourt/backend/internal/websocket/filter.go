@@ -0,0 +1,59 @@
+package websocket
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Filter selects which messages a subscriber receives. An empty filter
+// receives everything; otherwise a message is delivered if it is about one
+// of the listed matches or tournaments. Presence messages reach everyone.
+type Filter struct {
+	Matches     map[string]bool
+	Tournaments map[string]bool
+}
+
+// ParseFilter reads topic filters from query parameters. Both repeated and
+// comma separated values are accepted:
+//
+//	?match=sofa_1&match=sofa_2
+//	?matches=sofa_1,sofa_2&tournaments=aus-open-2025
+func ParseFilter(q url.Values) Filter {
+	return Filter{
+		Matches:     parseList(q, "match", "matches"),
+		Tournaments: parseList(q, "tournament", "tournaments"),
+	}
+}
+
+func parseList(q url.Values, keys ...string) map[string]bool {
+	var set map[string]bool
+	for _, key := range keys {
+		for _, value := range q[key] {
+			for _, item := range strings.Split(value, ",") {
+				item = strings.TrimSpace(item)
+				if item == "" {
+					continue
+				}
+				if set == nil {
+					set = make(map[string]bool)
+				}
+				set[item] = true
+			}
+		}
+	}
+	return set
+}
+
+// Empty reports whether the filter lets every message through
+func (f Filter) Empty() bool {
+	return len(f.Matches) == 0 && len(f.Tournaments) == 0
+}
+
+// Allows reports whether msg should be delivered to a subscriber with this filter
+func (f Filter) Allows(msg *Message) bool {
+	if f.Empty() || msg.Presence != nil {
+		return true
+	}
+	matchID, tournamentID := msg.topics()
+	return (matchID != "" && f.Matches[matchID]) || (tournamentID != "" && f.Tournaments[tournamentID])
+}
@@ -1,109 +1,388 @@
 package websocket
 
 import (
-	"encoding/json"
 	"log"
 	"net/http"
+	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"hardcourt/backend/internal/domain"
 
 	"github.com/gorilla/websocket"
 )
 
+const (
+	// Time allowed to write a message to the peer
+	writeWait = 10 * time.Second
+
+	// Time allowed to read the next pong message from the peer
+	pongWait = 60 * time.Second
+
+	// Send pings to peer with this period. Must be less than pongWait.
+	pingPeriod = (pongWait * 9) / 10
+
+	// Maximum message size allowed from peer
+	maxMessageSize = 512
+
+	// Outgoing messages buffered per client
+	sendBufferSize = 256
+
+	// Consecutive dropped messages after which a slow client is evicted
+	maxDroppedMessages = 32
+
+	// Messages kept for clients resuming with a last event ID
+	replayBufferSize = 1024
+
+	// Message IDs per millisecond of the hub's start time, see firstID
+	idsPerMillisecond = 1000
+)
+
 var upgrader = websocket.Upgrader{
 	CheckOrigin: func(r *http.Request) bool {
 		return true
 	},
+	Subprotocols: []string{SubprotocolJSON, SubprotocolProtobuf},
 }
 
+// codecs are the encodings every message is prepared in
+var codecs = []Codec{jsonCodec, protobufCodec}
+
 type Hub struct {
-	clients    map[*Client]bool
-	broadcast  chan []byte
-	register   chan *Client
-	unregister chan *Client
-	mu         sync.Mutex
+	subscribers map[*subscriber]bool
+	broadcast   chan *Message
+	mu          sync.Mutex
+
+	// Replay buffer shared by websocket and SSE subscribers. nextID is the
+	// last ID handed out, starting from firstID.
+	nextID uint64
+	replay []*Message
+
+	// Subscribers per topic, and those without a filter, guarded by mu
+	matchViewers      map[string]int64
+	tournamentViewers map[string]int64
+	overlapViewers    map[string]int64
+	unfiltered        int64
+
+	// Latest presence totals, across replicas when a store is configured
+	presenceMu sync.RWMutex
+	presence   Presence
+
+	// Metrics
+	connectedClients    atomic.Int64
+	droppedMessages     atomic.Int64
+	slowClientEvictions atomic.Int64
+	bytesSent           atomic.Int64
+}
+
+// Metrics is a snapshot of the hub's connection health counters
+type Metrics struct {
+	ConnectedClients    int64 `json:"connected_clients"`
+	DroppedMessages     int64 `json:"dropped_messages"`
+	SlowClientEvictions int64 `json:"slow_client_evictions"`
+	BytesSent           int64 `json:"bytes_sent"`
+}
+
+// Message types sent to clients
+const (
+	MessageMatchUpdate = "match_update"
+	MessageMatchEvent  = "match_event"
+	MessageResync      = "resync" // Replay could not cover the gap, refetch state over REST
+	MessagePresence    = "presence"
+)
+
+// Message is the envelope for everything the hub sends to clients
+type Message struct {
+	ID       uint64             `json:"id,omitempty"`
+	Type     string             `json:"type"`
+	Match    *domain.Match      `json:"match,omitempty"`
+	Event    *domain.MatchEvent `json:"event,omitempty"`
+	Presence *Presence          `json:"presence,omitempty"`
+
+	data    []byte // JSON encoding, set once by the hub
+	encoded map[string][]byte
+}
+
+// encode prepares the message in every codec so subscribers share the bytes
+func (m *Message) encode() error {
+	m.encoded = make(map[string][]byte, len(codecs))
+	for _, codec := range codecs {
+		data, err := codec.Encode(m)
+		if err != nil {
+			return err
+		}
+		m.encoded[codec.Name()] = data
+	}
+	m.data = m.encoded[jsonCodec.Name()]
+	return nil
+}
+
+// topics returns the match and tournament the message is about
+func (m *Message) topics() (matchID, tournamentID string) {
+	switch {
+	case m.Match != nil:
+		return m.Match.ID, m.Match.TournamentID
+	case m.Event != nil:
+		return m.Event.MatchID, m.Event.TournamentID
+	}
+	return "", ""
+}
+
+// subscriber is one consumer of the broadcast stream, either a websocket
+// client or an SSE response
+type subscriber struct {
+	filter Filter
+	send   chan *Message
+
+	// Consecutive messages dropped because send was full; guarded by Hub.mu
+	dropped int
 }
 
 type Client struct {
-	hub  *Hub
-	conn *websocket.Conn
-	send chan []byte
+	hub   *Hub
+	conn  *websocket.Conn
+	sub   *subscriber
+	codec Codec
 }
 
 func NewHub() *Hub {
 	return &Hub{
-		broadcast:  make(chan []byte),
-		register:   make(chan *Client),
-		unregister: make(chan *Client),
-		clients:    make(map[*Client]bool),
+		broadcast:         make(chan *Message),
+		subscribers:       make(map[*subscriber]bool),
+		matchViewers:      make(map[string]int64),
+		tournamentViewers: make(map[string]int64),
+		overlapViewers:    make(map[string]int64),
+		presence:          newPresence(),
+		nextID:            firstID(time.Now()),
 	}
 }
 
+// firstID numbers a hub's messages from its start time, so IDs from an
+// earlier process or another replica do not look like this hub's own: they
+// fall before its replay buffer or after its latest message, and resuming
+// clients get a resync instead of a silent gap. The IDs stay below 2^53 so
+// JavaScript clients can hold them.
+func firstID(start time.Time) uint64 {
+	return uint64(start.UnixMilli()) * idsPerMillisecond
+}
+
 func (h *Hub) Run() {
-	for {
-		select {
-		case client := <-h.register:
-			h.mu.Lock()
-			h.clients[client] = true
+	for msg := range h.broadcast {
+		h.mu.Lock()
+		// Presence is a snapshot, so it is neither numbered nor replayed
+		replayable := msg.Type != MessagePresence
+		if replayable {
+			msg.ID = h.nextID + 1
+		}
+		if err := msg.encode(); err != nil {
 			h.mu.Unlock()
-		case client := <-h.unregister:
-			h.mu.Lock()
-			if _, ok := h.clients[client]; ok {
-				delete(h.clients, client)
-				close(client.send)
+			log.Println("Error marshalling message:", err)
+			continue
+		}
+		if replayable {
+			h.nextID = msg.ID
+			h.replay = append(h.replay, msg)
+			if len(h.replay) > replayBufferSize {
+				h.replay = h.replay[len(h.replay)-replayBufferSize:]
 			}
-			h.mu.Unlock()
-		case message := <-h.broadcast:
-			h.mu.Lock()
-			for client := range h.clients {
-				select {
-				case client.send <- message:
-				default:
-					close(client.send)
-					delete(h.clients, client)
+		}
+
+		for sub := range h.subscribers {
+			if !sub.filter.Allows(msg) {
+				continue
+			}
+			select {
+			case sub.send <- msg:
+				sub.dropped = 0
+			default:
+				// Slow client: drop this message, evict if it keeps falling behind
+				h.droppedMessages.Add(1)
+				sub.dropped++
+				if sub.dropped >= maxDroppedMessages {
+					h.slowClientEvictions.Add(1)
+					h.removeSubscriber(sub)
 				}
 			}
-			h.mu.Unlock()
 		}
+		h.mu.Unlock()
+	}
+}
+
+// subscribe registers a new subscriber. If lastID is non-zero, the buffered
+// messages after it are returned so the caller can resume the stream; the
+// first return value reports whether the replay buffer covered the gap.
+func (h *Hub) subscribe(filter Filter, lastID uint64) (*subscriber, []*Message, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := &subscriber{filter: filter, send: make(chan *Message, sendBufferSize)}
+	h.subscribers[sub] = true
+	h.countTopics(filter, 1)
+	h.connectedClients.Store(int64(len(h.subscribers)))
+
+	if lastID == 0 {
+		return sub, nil, true
+	}
+
+	// IDs are contiguous, so the gap is covered if nothing was missed at all
+	// or the oldest buffered message directly follows lastID. An ID past the
+	// latest one was handed out by another process.
+	covered := lastID == h.nextID ||
+		(lastID < h.nextID && len(h.replay) > 0 && h.replay[0].ID <= lastID+1)
+
+	var backlog []*Message
+	for _, msg := range h.replay {
+		if msg.ID > lastID && filter.Allows(msg) {
+			backlog = append(backlog, msg)
+		}
+	}
+	return sub, backlog, covered
+}
+
+// unsubscribe removes a subscriber if the hub has not already evicted it
+func (h *Hub) unsubscribe(sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.removeSubscriber(sub)
+}
+
+// removeSubscriber drops a subscriber and closes its send channel. Callers hold h.mu.
+func (h *Hub) removeSubscriber(sub *subscriber) {
+	if _, ok := h.subscribers[sub]; ok {
+		delete(h.subscribers, sub)
+		h.countTopics(sub.filter, -1)
+		close(sub.send)
+		h.connectedClients.Store(int64(len(h.subscribers)))
+	}
+}
+
+// Metrics returns a snapshot of the hub's connection health counters
+func (h *Hub) Metrics() Metrics {
+	return Metrics{
+		ConnectedClients:    h.connectedClients.Load(),
+		DroppedMessages:     h.droppedMessages.Load(),
+		SlowClientEvictions: h.slowClientEvictions.Load(),
+		BytesSent:           h.bytesSent.Load(),
 	}
 }
 
 // BroadcastMatchUpdate sends a match update to all connected clients
 func (h *Hub) BroadcastMatchUpdate(match *domain.Match) {
-	data, err := json.Marshal(match)
-	if err != nil {
-		log.Println("Error marshalling match:", err)
-		return
-	}
-	h.broadcast <- data
+	h.broadcast <- &Message{Type: MessageMatchUpdate, Match: match}
+}
+
+// BroadcastEvent forwards a typed match event to all connected clients
+func (h *Hub) BroadcastEvent(event *domain.MatchEvent) {
+	h.broadcast <- &Message{Type: MessageMatchEvent, Event: event}
 }
 
+// parseLastID reads a resume position from a header or query value
+func parseLastID(value string) uint64 {
+	id, _ := strconv.ParseUint(value, 10, 64)
+	return id
+}
+
+// ServeWs upgrades the request to a websocket and streams hub messages.
+// Query parameters select topics (see ParseFilter) and last_event_id resumes
+// from the replay buffer. Messages are JSON text frames unless the client
+// negotiates protobuf binary frames (see negotiateCodec).
 func (h *Hub) ServeWs(w http.ResponseWriter, r *http.Request) {
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Println(err)
 		return
 	}
-	client := &Client{hub: h, conn: conn, send: make(chan []byte, 256)}
-	client.hub.register <- client
 
-	// Allow collection of memory by starting write pump
-	go client.writePump()
+	sub, backlog, covered := h.subscribe(ParseFilter(r.URL.Query()), parseLastID(r.URL.Query().Get("last_event_id")))
+	client := &Client{hub: h, conn: conn, sub: sub, codec: negotiateCodec(r, conn.Subprotocol())}
+
+	// Allow collection of memory referenced by the caller by doing all work in
+	// new goroutines.
+	go client.writePump(backlog, covered)
+	go client.readPump()
+}
+
+// readPump reads from the connection until it fails. Clients only send
+// control frames, but reading is needed to process pongs and close frames.
+func (c *Client) readPump() {
+	defer func() {
+		c.hub.unsubscribe(c.sub)
+		c.conn.Close()
+	}()
+	c.conn.SetReadLimit(maxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				log.Printf("websocket read error: %v", err)
+			}
+			return
+		}
+	}
 }
 
-func (c *Client) writePump() {
+// writePump sends the replay backlog, then queued messages and periodic
+// pings. A write that does not complete within writeWait closes the connection.
+func (c *Client) writePump(backlog []*Message, covered bool) {
+	ticker := time.NewTicker(pingPeriod)
 	defer func() {
+		ticker.Stop()
 		c.conn.Close()
 	}()
+
+	if !covered {
+		backlog = append([]*Message{resyncMessage()}, backlog...)
+	}
+	for _, msg := range backlog {
+		if err := c.write(msg); err != nil {
+			return
+		}
+	}
+
 	for {
 		select {
-		case message, ok := <-c.send:
+		case msg, ok := <-c.sub.send:
 			if !ok {
+				// The hub closed the channel
+				c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
 				return
 			}
-			c.conn.WriteMessage(websocket.TextMessage, message)
+			if err := c.write(msg); err != nil {
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
 		}
 	}
 }
+
+func (c *Client) write(msg *Message) error {
+	frameType := websocket.TextMessage
+	if c.codec.Binary() {
+		frameType = websocket.BinaryMessage
+	}
+	data := msg.encoded[c.codec.Name()]
+
+	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	if err := c.conn.WriteMessage(frameType, data); err != nil {
+		return err
+	}
+	c.hub.bytesSent.Add(int64(len(data)))
+	return nil
+}
+
+// resyncMessage tells a resuming client that messages were lost
+func resyncMessage() *Message {
+	msg := &Message{Type: MessageResync}
+	msg.encode()
+	return msg
+}
@@ -0,0 +1,221 @@
+package websocket
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"hardcourt/backend/internal/domain"
+
+	"github.com/gorilla/websocket"
+)
+
+func startHub(t *testing.T) (*Hub, string) {
+	t.Helper()
+	hub := NewHub()
+	go hub.Run()
+
+	server := httptest.NewServer(http.HandlerFunc(hub.ServeWs))
+	t.Cleanup(server.Close)
+
+	return hub, "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for condition")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestHub_BroadcastAndMetrics(t *testing.T) {
+	hub, url := startHub(t)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	defer conn.Close()
+
+	waitFor(t, func() bool { return hub.Metrics().ConnectedClients == 1 })
+
+	hub.BroadcastMatchUpdate(&domain.Match{ID: "m1"})
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	_, data, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("Failed to read message: %v", err)
+	}
+
+	var msg Message
+	if err := json.Unmarshal(data, &msg); err != nil {
+		t.Fatalf("Failed to decode message: %v", err)
+	}
+	if msg.Type != MessageMatchUpdate || msg.Match == nil || msg.Match.ID != "m1" {
+		t.Errorf("Expected match_update for m1, got %+v", msg)
+	}
+
+	waitFor(t, func() bool { return hub.Metrics().BytesSent == int64(len(data)) })
+}
+
+func TestHub_UnregistersClosedClients(t *testing.T) {
+	hub, url := startHub(t)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	waitFor(t, func() bool { return hub.Metrics().ConnectedClients == 1 })
+
+	conn.Close()
+	waitFor(t, func() bool { return hub.Metrics().ConnectedClients == 0 })
+}
+
+func TestHub_EvictsSlowClients(t *testing.T) {
+	hub := NewHub()
+	go hub.Run()
+
+	// A subscriber nobody drains, so its buffer fills up
+	hub.subscribe(Filter{}, 0)
+
+	for i := 0; i < sendBufferSize+maxDroppedMessages; i++ {
+		hub.BroadcastMatchUpdate(&domain.Match{ID: "m1"})
+	}
+
+	waitFor(t, func() bool { return hub.Metrics().SlowClientEvictions == 1 })
+
+	metrics := hub.Metrics()
+	if metrics.DroppedMessages != maxDroppedMessages {
+		t.Errorf("Expected %d dropped messages, got %d", maxDroppedMessages, metrics.DroppedMessages)
+	}
+	if metrics.ConnectedClients != 0 {
+		t.Errorf("Expected evicted client to be removed, got %d connected", metrics.ConnectedClients)
+	}
+}
+
+func TestHub_TopicFilterAndReplay(t *testing.T) {
+	hub := NewHub()
+	start := hub.nextID
+	go hub.Run()
+
+	hub.BroadcastMatchUpdate(&domain.Match{ID: "m1", TournamentID: "t1"})
+	hub.BroadcastMatchUpdate(&domain.Match{ID: "m2", TournamentID: "t2"})
+	hub.BroadcastEvent(&domain.MatchEvent{Type: domain.EventGameWon, MatchID: "m1", TournamentID: "t1"})
+
+	waitFor(t, func() bool {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		return hub.nextID == start+3
+	})
+
+	filter := ParseFilter(map[string][]string{"tournaments": {"t1"}})
+	sub, backlog, covered := hub.subscribe(filter, start+1)
+	defer hub.unsubscribe(sub)
+
+	if !covered {
+		t.Error("Expected replay buffer to cover the gap")
+	}
+	if len(backlog) != 1 || backlog[0].ID != start+3 || backlog[0].Type != MessageMatchEvent {
+		t.Fatalf("Expected only the t1 event after ID 1, got %d messages", len(backlog))
+	}
+
+	hub.BroadcastMatchUpdate(&domain.Match{ID: "m2", TournamentID: "t2"})
+	hub.BroadcastMatchUpdate(&domain.Match{ID: "m3", TournamentID: "t1"})
+
+	select {
+	case msg := <-sub.send:
+		if msg.Match == nil || msg.Match.ID != "m3" {
+			t.Errorf("Expected filtered update for m3, got %+v", msg)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for filtered message")
+	}
+}
+
+func TestHub_ResyncsIDsFromAnotherProcess(t *testing.T) {
+	hub := NewHub()
+	start := hub.nextID
+	go hub.Run()
+
+	hub.BroadcastMatchUpdate(&domain.Match{ID: "m1"})
+	waitFor(t, func() bool {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		return hub.nextID == start+1
+	})
+
+	// A restarted process starts numbering after the IDs it handed out before
+	if firstID(time.Now().Add(-time.Hour)) >= start {
+		t.Fatal("Expected a later start to number its messages higher")
+	}
+
+	for _, tc := range []struct {
+		name    string
+		lastID  uint64
+		covered bool
+	}{
+		{"up to date", start + 1, true},
+		{"from a replica that started later", start + 500, false},
+		{"from before a restart", start - 40, false},
+	} {
+		sub, _, covered := hub.subscribe(Filter{}, tc.lastID)
+		hub.unsubscribe(sub)
+		if covered != tc.covered {
+			t.Errorf("%s: covered = %v, want %v", tc.name, covered, tc.covered)
+		}
+	}
+}
+
+func TestHub_ServeSSE(t *testing.T) {
+	hub := NewHub()
+	start := hub.nextID
+	go hub.Run()
+
+	hub.BroadcastMatchUpdate(&domain.Match{ID: "m1"})
+	hub.BroadcastMatchUpdate(&domain.Match{ID: "m2"})
+
+	server := httptest.NewServer(http.HandlerFunc(hub.ServeSSE))
+	defer server.Close()
+
+	waitFor(t, func() bool {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		return hub.nextID == start+2
+	})
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Set("Last-Event-ID", strconv.FormatUint(start+1, 10))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Expected text/event-stream, got %q", ct)
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	var lines []string
+	for len(lines) < 5 {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("Failed to read stream: %v", err)
+		}
+		lines = append(lines, strings.TrimSpace(line))
+	}
+
+	// retry hint, blank line, then the replayed message
+	if lines[2] != "id: "+strconv.FormatUint(start+2, 10) || lines[3] != "event: match_update" || !strings.Contains(lines[4], `"id":"m2"`) {
+		t.Errorf("Expected replay of message 2, got %q", lines)
+	}
+}
@@ -0,0 +1,226 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// How often presence counts are published and broadcast
+	PresenceInterval = 10 * time.Second
+
+	presenceKeyPrefix = "hardcourt:presence:"
+)
+
+// Presence counts subscribers per topic. Matches and Tournaments count the
+// subscribers that filtered on them, and Overlap, keyed "match/tournament",
+// those that filtered on both. Subscribers without a filter receive every
+// match and are counted once in Unfiltered. A match's viewers are its
+// Matches and its tournament's Tournaments counts, less their Overlap, plus
+// Unfiltered. Total counts every subscriber.
+type Presence struct {
+	Matches     map[string]int64 `json:"matches"`
+	Tournaments map[string]int64 `json:"tournaments"`
+	Overlap     map[string]int64 `json:"overlap"`
+	Unfiltered  int64            `json:"unfiltered"`
+	Total       int64            `json:"total"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// overlapKey keys Presence.Overlap
+func overlapKey(matchID, tournamentID string) string {
+	return matchID + "/" + tournamentID
+}
+
+// add sums other into p
+func (p *Presence) add(other Presence) {
+	for id, n := range other.Matches {
+		p.Matches[id] += n
+	}
+	for id, n := range other.Tournaments {
+		p.Tournaments[id] += n
+	}
+	for key, n := range other.Overlap {
+		p.Overlap[key] += n
+	}
+	p.Unfiltered += other.Unfiltered
+	p.Total += other.Total
+}
+
+func newPresence() Presence {
+	return Presence{Matches: make(map[string]int64), Tournaments: make(map[string]int64), Overlap: make(map[string]int64)}
+}
+
+// PresenceStore shares presence counts between replicas
+type PresenceStore interface {
+	// Publish records this replica's counts and returns the totals across all replicas
+	Publish(ctx context.Context, local Presence) (Presence, error)
+}
+
+// RedisPresenceStore keeps one key per replica that expires if the replica
+// stops publishing, so a crashed replica's viewers age out.
+type RedisPresenceStore struct {
+	rdb       *redis.Client
+	replicaID string
+	ttl       time.Duration
+}
+
+// NewRedisPresenceStore creates a store identified by this process's host and PID
+func NewRedisPresenceStore(rdb *redis.Client) *RedisPresenceStore {
+	hostname, _ := os.Hostname()
+	return &RedisPresenceStore{
+		rdb:       rdb,
+		replicaID: fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		ttl:       3 * PresenceInterval,
+	}
+}
+
+// Publish stores the local counts and sums every live replica's counts
+func (s *RedisPresenceStore) Publish(ctx context.Context, local Presence) (Presence, error) {
+	data, err := json.Marshal(local)
+	if err != nil {
+		return Presence{}, fmt.Errorf("failed to marshal presence: %w", err)
+	}
+	if err := s.rdb.Set(ctx, presenceKeyPrefix+s.replicaID, data, s.ttl).Err(); err != nil {
+		return Presence{}, fmt.Errorf("failed to publish presence: %w", err)
+	}
+
+	var keys []string
+	iter := s.rdb.Scan(ctx, 0, presenceKeyPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return Presence{}, fmt.Errorf("failed to list presence keys: %w", err)
+	}
+
+	values, err := s.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return Presence{}, fmt.Errorf("failed to read presence: %w", err)
+	}
+
+	totals := newPresence()
+	for _, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			// Expired between SCAN and MGET
+			continue
+		}
+		var replica Presence
+		if err := json.Unmarshal([]byte(str), &replica); err != nil {
+			continue
+		}
+		totals.add(replica)
+	}
+	totals.UpdatedAt = local.UpdatedAt
+	return totals, nil
+}
+
+// Remove deletes this replica's counts, used on graceful shutdown
+func (s *RedisPresenceStore) Remove(ctx context.Context) error {
+	return s.rdb.Del(ctx, presenceKeyPrefix+s.replicaID).Err()
+}
+
+// RunPresence periodically refreshes presence counts and broadcasts them as
+// a presence message. With a nil store only this replica's subscribers are
+// counted.
+func (h *Hub) RunPresence(ctx context.Context, store PresenceStore, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			presence := h.refreshPresence(ctx, store)
+			h.broadcast <- &Message{Type: MessagePresence, Presence: &presence}
+		}
+	}
+}
+
+// refreshPresence recomputes the presence totals and stores them for MatchViewers
+func (h *Hub) refreshPresence(ctx context.Context, store PresenceStore) Presence {
+	presence := h.localPresence()
+	if store != nil {
+		totals, err := store.Publish(ctx, presence)
+		if err != nil {
+			log.Printf("Presence store unavailable, using local counts: %v", err)
+		} else {
+			presence = totals
+		}
+	}
+
+	h.presenceMu.Lock()
+	h.presence = presence
+	h.presenceMu.Unlock()
+	return presence
+}
+
+// localPresence counts this replica's subscribers
+func (h *Hub) localPresence() Presence {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	presence := newPresence()
+	for id, n := range h.matchViewers {
+		presence.Matches[id] = n
+	}
+	for id, n := range h.tournamentViewers {
+		presence.Tournaments[id] = n
+	}
+	for key, n := range h.overlapViewers {
+		presence.Overlap[key] = n
+	}
+	presence.Unfiltered = h.unfiltered
+	presence.Total = int64(len(h.subscribers))
+	presence.UpdatedAt = time.Now()
+	return presence
+}
+
+// countTopics adjusts per-topic subscriber counts by delta, or the
+// unfiltered count for an empty filter. Callers hold h.mu.
+func (h *Hub) countTopics(filter Filter, delta int64) {
+	if filter.Empty() {
+		h.unfiltered += delta
+		return
+	}
+	for id := range filter.Matches {
+		h.matchViewers[id] += delta
+		if h.matchViewers[id] <= 0 {
+			delete(h.matchViewers, id)
+		}
+	}
+	for id := range filter.Tournaments {
+		h.tournamentViewers[id] += delta
+		if h.tournamentViewers[id] <= 0 {
+			delete(h.tournamentViewers, id)
+		}
+		for matchID := range filter.Matches {
+			key := overlapKey(matchID, id)
+			h.overlapViewers[key] += delta
+			if h.overlapViewers[key] <= 0 {
+				delete(h.overlapViewers, key)
+			}
+		}
+	}
+}
+
+// MatchViewers returns the number of subscribers receiving a match's
+// updates as of the last presence refresh: those that filtered on the match
+// or on its tournament, counted once, and those without a filter
+func (h *Hub) MatchViewers(matchID, tournamentID string) (int64, time.Time) {
+	h.presenceMu.RLock()
+	defer h.presenceMu.RUnlock()
+	viewers := h.presence.Matches[matchID] + h.presence.Unfiltered
+	if tournamentID != "" {
+		viewers += h.presence.Tournaments[tournamentID] - h.presence.Overlap[overlapKey(matchID, tournamentID)]
+	}
+	return viewers, h.presence.UpdatedAt
+}
@@ -0,0 +1,92 @@
+package websocket
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// replicaStore pretends another replica is serving the given counts
+type replicaStore struct {
+	other Presence
+}
+
+func (s replicaStore) Publish(ctx context.Context, local Presence) (Presence, error) {
+	totals := newPresence()
+	totals.add(local)
+	totals.add(s.other)
+	totals.UpdatedAt = local.UpdatedAt
+	return totals, nil
+}
+
+func TestHub_CountsTopicSubscribers(t *testing.T) {
+	hub := NewHub()
+
+	a, _, _ := hub.subscribe(ParseFilter(map[string][]string{"matches": {"m1,m2"}}), 0)
+	b, _, _ := hub.subscribe(ParseFilter(map[string][]string{"match": {"m1"}, "tournament": {"t1"}}), 0)
+	hub.subscribe(Filter{}, 0)
+
+	local := hub.localPresence()
+	if local.Matches["m1"] != 2 || local.Matches["m2"] != 1 || local.Tournaments["t1"] != 1 || local.Overlap["m1/t1"] != 1 || local.Unfiltered != 1 || local.Total != 3 {
+		t.Errorf("Unexpected local presence: %+v", local)
+	}
+
+	hub.unsubscribe(a)
+	hub.unsubscribe(b)
+
+	local = hub.localPresence()
+	if _, ok := local.Matches["m2"]; ok || local.Matches["m1"] != 0 || len(local.Overlap) != 0 || local.Total != 1 {
+		t.Errorf("Expected counts to drop with subscribers, got %+v", local)
+	}
+}
+
+func TestHub_PresenceAcrossReplicas(t *testing.T) {
+	hub := NewHub()
+	go hub.Run()
+
+	sub, _, _ := hub.subscribe(ParseFilter(map[string][]string{"match": {"m1"}}), 0)
+	defer hub.unsubscribe(sub)
+
+	store := replicaStore{other: Presence{
+		Matches:     map[string]int64{"m1": 40, "m9": 3},
+		Tournaments: map[string]int64{"t1": 10},
+		Overlap:     map[string]int64{"m1/t1": 4},
+		Unfiltered:  5,
+		Total:       60,
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go hub.RunPresence(ctx, store, 10*time.Millisecond)
+
+	// Presence is delivered despite the topic filter, without a replay ID
+	select {
+	case msg := <-sub.send:
+		if msg.Type != MessagePresence || msg.ID != 0 || msg.Presence == nil {
+			t.Fatalf("Expected an unnumbered presence message, got %+v", msg)
+		}
+		if msg.Presence.Matches["m1"] != 41 || msg.Presence.Total != 61 {
+			t.Errorf("Expected summed counts, got %+v", msg.Presence)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for presence")
+	}
+
+	// Subscribers without a filter watch every match
+	viewers, updatedAt := hub.MatchViewers("m1", "")
+	if viewers != 46 || updatedAt.IsZero() {
+		t.Errorf("Expected 46 viewers with a timestamp, got %d at %v", viewers, updatedAt)
+	}
+	if viewers, _ := hub.MatchViewers("m9", ""); viewers != 8 {
+		t.Errorf("Expected 8 viewers of m9, got %d", viewers)
+	}
+
+	// Tournament subscribers watch its matches, counted once if they also
+	// filtered on the match
+	if viewers, _ := hub.MatchViewers("m1", "t1"); viewers != 52 {
+		t.Errorf("Expected 52 viewers of m1 in t1, got %d", viewers)
+	}
+	if viewers, _ := hub.MatchViewers("m9", "t1"); viewers != 18 {
+		t.Errorf("Expected 18 viewers of m9 in t1, got %d", viewers)
+	}
+}
@@ -0,0 +1,96 @@
+package websocket
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// Comment lines sent to keep proxies from closing idle streams
+	sseKeepAlive = 15 * time.Second
+
+	// Reconnect delay suggested to EventSource clients, in milliseconds
+	sseRetryMillis = 3000
+)
+
+// ServeSSE streams hub messages as Server-Sent Events for clients that
+// cannot use websockets. It shares topic filters, message envelopes and the
+// replay buffer with ServeWs: each event carries the hub message ID, so a
+// reconnecting EventSource resumes via the Last-Event-ID header.
+func (h *Hub) ServeSSE(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	lastID := parseLastID(r.Header.Get("Last-Event-ID"))
+	if lastID == 0 {
+		lastID = parseLastID(r.URL.Query().Get("last_event_id"))
+	}
+
+	sub, backlog, covered := h.subscribe(ParseFilter(r.URL.Query()), lastID)
+	defer h.unsubscribe(sub)
+
+	// The stream outlives the server's WriteTimeout; deadlines are set per write instead
+	rc := http.NewResponseController(w)
+	rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	write := func(format string, args ...interface{}) error {
+		rc.SetWriteDeadline(time.Now().Add(writeWait))
+		n, err := fmt.Fprintf(w, format, args...)
+		if err != nil {
+			return err
+		}
+		h.bytesSent.Add(int64(n))
+		flusher.Flush()
+		return nil
+	}
+	send := func(msg *Message) error {
+		if msg.ID == 0 {
+			return write("event: %s\ndata: %s\n\n", msg.Type, msg.data)
+		}
+		return write("id: %d\nevent: %s\ndata: %s\n\n", msg.ID, msg.Type, msg.data)
+	}
+
+	if err := write("retry: %d\n\n", sseRetryMillis); err != nil {
+		return
+	}
+	if !covered {
+		backlog = append([]*Message{resyncMessage()}, backlog...)
+	}
+	for _, msg := range backlog {
+		if err := send(msg); err != nil {
+			return
+		}
+	}
+
+	ticker := time.NewTicker(sseKeepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case msg, ok := <-sub.send:
+			if !ok {
+				// Evicted as a slow client
+				return
+			}
+			if err := send(msg); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := write(": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
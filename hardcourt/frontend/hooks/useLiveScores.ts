@@ -29,8 +29,38 @@ export type Match = {
     fatigue_p2: number;
 };
 
+export type MatchEventType =
+    | 'point_won'
+    | 'game_won'
+    | 'break_of_serve'
+    | 'set_won'
+    | 'tiebreak_started'
+    | 'match_point_saved'
+    | 'match_finished'
+    | 'status_changed';
+
+export type MatchEvent = {
+    type: MatchEventType;
+    match_id: string;
+    tournament_id?: string;
+    player?: number;
+    set_number?: number;
+    set?: { set_number: number; games_p1: number; games_p2: number; tiebreak_p1?: number; tiebreak_p2?: number };
+    from_status?: string;
+    to_status?: string;
+    score: Match['score'];
+    timestamp: string;
+};
+
+type HubMessage =
+    | { type: 'match_update'; match: Match }
+    | { type: 'match_event'; event: MatchEvent };
+
+const MAX_RECENT_EVENTS = 50;
+
 export const useLiveScores = () => {
     const [matches, setMatches] = useState<Record<string, Match>>({});
+    const [events, setEvents] = useState<MatchEvent[]>([]);
     const [isConnected, setIsConnected] = useState(false);
     const [isLoading, setIsLoading] = useState(true);
     const wsRef = useRef<WebSocket | null>(null);
@@ -73,13 +103,17 @@ export const useLiveScores = () => {
 
         ws.onmessage = (event: MessageEvent) => {
             try {
-                const matchData: Match = JSON.parse(event.data);
-                setMatches((prev) => ({
-                    ...prev,
-                    [matchData.id]: matchData,
-                }));
+                const message: HubMessage = JSON.parse(event.data);
+                if (message.type === 'match_update') {
+                    setMatches((prev) => ({
+                        ...prev,
+                        [message.match.id]: message.match,
+                    }));
+                } else if (message.type === 'match_event') {
+                    setEvents((prev) => [message.event, ...prev].slice(0, MAX_RECENT_EVENTS));
+                }
             } catch (e) {
-                console.error('Failed to parse match data', e);
+                console.error('Failed to parse hub message', e);
             }
         };
 
@@ -97,5 +131,5 @@ export const useLiveScores = () => {
         };
     }, []);
 
-    return { matches, isConnected, isLoading };
+    return { matches, events, isConnected, isLoading };
 };